@@ -178,6 +178,14 @@ type BucketStatus struct {
 	// This is cleared when provisioning is successful.
 	// +optional
 	Error *TimestampedError `json:"error,omitempty"`
+
+	// conditions describe the current state of the Bucket.
+	// Condition types used by COSI are Ready, Provisioned, Bound, and DeletionBlocked.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=16
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -97,10 +97,13 @@ type BucketAccessSpec struct {
 
 // BucketAccessStatus defines the observed state of BucketAccess.
 // +kubebuilder:validation:XValidation:message="accountID cannot be removed once set",rule="!has(oldSelf.accountID) || has(self.accountID)"
+// +kubebuilder:validation:XValidation:message="accountID can only change when the previous account is retiring",rule="!has(oldSelf.accountID) || !has(self.accountID) || self.accountID == oldSelf.accountID || (has(self.retiringAccounts) && self.retiringAccounts.exists(a, a.accountID == oldSelf.accountID))"
 // +kubebuilder:validation:XValidation:message="accessedBuckets cannot be removed once set",rule="!has(oldSelf.accessedBuckets) || has(self.accessedBuckets)"
 // +kubebuilder:validation:XValidation:message="driverName cannot be removed once set",rule="!has(oldSelf.driverName) || has(self.driverName)"
 // +kubebuilder:validation:XValidation:message="authenticationType cannot be removed once set",rule="!has(oldSelf.authenticationType) || has(self.authenticationType)"
 // +kubebuilder:validation:XValidation:message="parameters cannot be removed once set",rule="!has(oldSelf.parameters) || has(self.parameters)"
+// +kubebuilder:validation:XValidation:message="credentialRotationPeriod cannot be removed once set",rule="!has(oldSelf.credentialRotationPeriod) || has(self.credentialRotationPeriod)"
+// +kubebuilder:validation:XValidation:message="credentialRotationGracePeriod cannot be removed once set",rule="!has(oldSelf.credentialRotationGracePeriod) || has(self.credentialRotationGracePeriod)"
 type BucketAccessStatus struct {
 	// readyToUse indicates that the BucketAccess is ready for consumption by workloads.
 	// +required
@@ -108,15 +111,34 @@ type BucketAccessStatus struct {
 
 	// accountID is the unique identifier for the backend access known to the driver.
 	// This field is populated by the COSI Sidecar once access has been successfully granted.
+	// The accountID changes only during zero-downtime credential rotation, when the previous
+	// account is moved to retiringAccounts.
 	// Must be at most 2048 characters and consist only of alphanumeric characters ([a-z0-9A-Z]),
 	// dashes (-), dots (.), underscores (_), and forward slash (/).
 	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=2048
 	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9/._-]+$`
-	// +kubebuilder:validation:XValidation:message="accountId is immutable once set",rule="self == oldSelf"
 	AccountID string `json:"accountID,omitempty"`
 
+	// accountGeneration counts the number of times access has been granted to a new account for
+	// zero-downtime credential rotation. COSI derives the name of the current account from it.
+	// This field is populated by the COSI Sidecar.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:XValidation:message="accountGeneration cannot decrease",rule="self >= oldSelf"
+	AccountGeneration int64 `json:"accountGeneration,omitempty"`
+
+	// retiringAccounts lists previous accounts that remain valid after zero-downtime credential
+	// rotation so that workloads have time to pick up new credentials. Each account is revoked once
+	// its revocation time has passed, or when the BucketAccess is deleted.
+	// This field is populated by the COSI Sidecar.
+	// +optional
+	// +listType=map
+	// +listMapKey=accountID
+	// +kubebuilder:validation:MaxItems=16
+	RetiringAccounts []RetiringAccount `json:"retiringAccounts,omitempty"`
+
 	// accessedBuckets is a list of Buckets the provisioned access must have permissions for, along
 	// with per-Bucket access options. This field is populated by the COSI Controller based on the
 	// referenced BucketClaims in the spec.
@@ -156,10 +178,50 @@ type BucketAccessStatus struct {
 	// +kubebuilder:validation:XValidation:message="accessedBuckets is immutable once set",rule="self == oldSelf"
 	Parameters map[string]string `json:"parameters,omitempty"`
 
+	// credentialRotationPeriod holds a copy of the BucketAccessClass credential rotation period from
+	// the time of BucketAccess provisioning. This field is populated by the COSI Controller.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="credentialRotationPeriod is immutable once set",rule="self == oldSelf"
+	CredentialRotationPeriod *metav1.Duration `json:"credentialRotationPeriod,omitempty"`
+
+	// credentialRotationGracePeriod holds a copy of the BucketAccessClass credential rotation grace
+	// period from the time of BucketAccess provisioning. This field is populated by the COSI Controller.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="credentialRotationGracePeriod is immutable once set",rule="self == oldSelf"
+	CredentialRotationGracePeriod *metav1.Duration `json:"credentialRotationGracePeriod,omitempty"`
+
+	// lastCredentialRotationTime is the time at which access credentials were last rotated.
+	// This field is populated by the COSI Sidecar after each successful rotation.
+	// +optional
+	LastCredentialRotationTime *metav1.Time `json:"lastCredentialRotationTime,omitempty"`
+
+	// credentialRotationRequested is true when credential rotation was requested with the
+	// 'objectstorage.k8s.io/rotate-credentials' annotation, and the rotation has not yet completed.
+	// The COSI Sidecar records the request here before removing the annotation, and clears it when
+	// the rotation is recorded in lastCredentialRotationTime.
+	// This field is populated by the COSI Sidecar.
+	// +optional
+	CredentialRotationRequested bool `json:"credentialRotationRequested,omitempty"`
+
+	// credentialsExpiryTime is the time at which the current access credentials expire, as reported
+	// by the driver. COSI renews the credentials ahead of expiry. Unset if the driver does not report
+	// an expiry time for the credentials.
+	// This field is populated by the COSI Sidecar.
+	// +optional
+	CredentialsExpiryTime *metav1.Time `json:"credentialsExpiryTime,omitempty"`
+
 	// error holds the most recent error message, with a timestamp.
 	// This is cleared when provisioning is successful.
 	// +optional
 	Error *TimestampedError `json:"error,omitempty"`
+
+	// conditions describe the current state of the BucketAccess.
+	// Condition types used by COSI are Ready, Bound, AccessGranted, and DeletionBlocked.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=16
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // BucketClaimAccess selects a BucketClaim for access, defines access parameters for the
@@ -236,6 +298,23 @@ type AccessedBucket struct {
 	BucketClaimName string `json:"bucketClaimName,omitempty"`
 }
 
+// RetiringAccount identifies a previous backend account that remains valid during a credential
+// rotation grace period.
+type RetiringAccount struct {
+	// accountID is the unique identifier for the previous backend access known to the driver.
+	// Must be at most 2048 characters and consist only of alphanumeric characters ([a-z0-9A-Z]),
+	// dashes (-), dots (.), underscores (_), and forward slash (/).
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9/._-]+$`
+	AccountID string `json:"accountID,omitempty"`
+
+	// revokeAfter is the time after which COSI revokes the account.
+	// +required
+	RevokeAfter metav1.Time `json:"revokeAfter,omitzero"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental v1alpha2 changes"
@@ -71,6 +71,35 @@ type BucketAccessClassSpec struct {
 	//  - MultipleBuckets: A BucketAccess may reference multiple (1 or more) BucketClaims.
 	// +optional
 	MultiBucketAccess MultiBucketAccess `json:"multiBucketAccess,omitempty"`
+
+	// credentialRotationPeriod is the maximum age of access credentials provisioned for a
+	// BucketAccess using this class. Once credentials are older than this period, COSI asks the
+	// driver to rotate them and updates the BucketAccess Secrets with the new credentials.
+	// When omitted, credentials are only rotated on request (see the
+	// 'objectstorage.k8s.io/rotate-credentials' BucketAccess annotation).
+	// Rotation applies only to the 'Key' authentication type. Must be at least 1 hour.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="credentialRotationPeriod must be at least 1 hour",rule="duration(self) >= duration('1h')"
+	CredentialRotationPeriod *metav1.Duration `json:"credentialRotationPeriod,omitempty"`
+
+	// credentialRotationGracePeriod enables zero-downtime credential rotation for a BucketAccess
+	// using this class. When set, COSI rotates credentials by granting access to a new account and
+	// updating the BucketAccess Secrets with the new account's credentials. The previous account
+	// remains valid for this grace period, giving workloads time to pick up the new credentials,
+	// and is revoked afterwards.
+	// When omitted, COSI asks the driver to rotate the credentials of the existing account in place.
+	// Rotation applies only to the 'Key' authentication type. Must be at least 1 minute.
+	// +optional
+	// +kubebuilder:validation:XValidation:message="credentialRotationGracePeriod must be at least 1 minute",rule="duration(self) >= duration('1m')"
+	CredentialRotationGracePeriod *metav1.Duration `json:"credentialRotationGracePeriod,omitempty"`
+
+	// allowedNamespaces is a label selector for the Namespaces from which BucketAccesses may use
+	// this BucketAccessClass. Access is not provisioned for a BucketAccess in a Namespace that
+	// doesn't match the selector, and the BucketAccess reports an error in its status.
+	// When omitted, the BucketAccessClass may be used from any Namespace. An empty selector matches
+	// all Namespaces.
+	// +optional
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
 }
 
 // MultiBucketAccess specifies whether a BucketAccess can reference multiple BucketClaims.
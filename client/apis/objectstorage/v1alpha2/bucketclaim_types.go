@@ -99,6 +99,14 @@ type BucketClaimStatus struct {
 	// This is cleared when provisioning is successful.
 	// +optional
 	Error *TimestampedError `json:"error,omitempty"`
+
+	// conditions describe the current state of the BucketClaim.
+	// Condition types used by COSI are Ready, Bound, Provisioned, and DeletionBlocked.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=16
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -48,6 +48,14 @@ type BucketClassSpec struct {
 	// +kubebuilder:validation:MinProperties=1
 	// +kubebuilder:validation:MaxProperties=512
 	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// allowedNamespaces is a label selector for the Namespaces from which BucketClaims may use this
+	// BucketClass. A Bucket is not provisioned for a BucketClaim in a Namespace that doesn't match
+	// the selector, and the BucketClaim reports an error in its status.
+	// When omitted, the BucketClass may be used from any Namespace. An empty selector matches all
+	// Namespaces.
+	// +optional
+	AllowedNamespaces *metav1.LabelSelector `json:"allowedNamespaces,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BucketQuotaSpec defines the limits of a BucketQuota.
+// +kubebuilder:validation:XValidation:message="bucketClassName is immutable",rule="has(oldSelf.bucketClassName) == has(self.bucketClassName)"
+// +kubebuilder:validation:XValidation:message="bucketAccessClassName is immutable",rule="has(oldSelf.bucketAccessClassName) == has(self.bucketAccessClassName)"
+type BucketQuotaSpec struct {
+	// hard is the maximum number of each COSI resource in the Namespace that may count toward the
+	// quota. A resource type that is omitted is not limited by the quota.
+	// +required
+	Hard BucketQuotaCounts `json:"hard,omitzero"`
+
+	// bucketClassName limits the quota to BucketClaims that use the named BucketClass.
+	// When omitted, BucketClaims using any BucketClass count toward the quota. Immutable.
+	// Must be a valid Kubernetes resource name: at most 253 characters, consisting only of
+	// lower-case alphanumeric characters, hyphens, and periods, starting and ending with an
+	// alphanumeric character.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:message="name must be a valid resource name",rule="!format.dns1123Subdomain().validate(self).hasValue()"
+	// +kubebuilder:validation:XValidation:message="bucketClassName is immutable",rule="self == oldSelf"
+	BucketClassName string `json:"bucketClassName,omitempty"`
+
+	// bucketAccessClassName limits the quota to BucketAccesses that use the named
+	// BucketAccessClass. When omitted, BucketAccesses using any BucketAccessClass count toward the
+	// quota. Immutable.
+	// Must be a valid Kubernetes resource name: at most 253 characters, consisting only of
+	// lower-case alphanumeric characters, hyphens, and periods, starting and ending with an
+	// alphanumeric character.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:XValidation:message="name must be a valid resource name",rule="!format.dns1123Subdomain().validate(self).hasValue()"
+	// +kubebuilder:validation:XValidation:message="bucketAccessClassName is immutable",rule="self == oldSelf"
+	BucketAccessClassName string `json:"bucketAccessClassName,omitempty"`
+}
+
+// BucketQuotaCounts holds a number for each COSI resource type that a BucketQuota limits.
+// +kubebuilder:validation:MinProperties=1
+type BucketQuotaCounts struct {
+	// bucketClaims is the number of dynamically-provisioned BucketClaims that are bound to a
+	// Bucket. BucketClaims that bind to an existing Bucket don't count toward the quota.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BucketClaims *int32 `json:"bucketClaims,omitempty"`
+
+	// bucketAccesses is the number of BucketAccesses that have been initialized for provisioning
+	// by a driver.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	BucketAccesses *int32 `json:"bucketAccesses,omitempty"`
+}
+
+// BucketQuotaStatus defines the observed state of BucketQuota.
+type BucketQuotaStatus struct {
+	// used is the number of each COSI resource type in the Namespace that counts toward the quota.
+	// The COSI Controller reserves usage here before provisioning a resource, and recalculates
+	// usage when resources are deleted. Resources are not provisioned until usage is calculated.
+	// This field is populated by the COSI Controller.
+	// +optional
+	Used BucketQuotaCounts `json:"used,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=unapproved, experimental v1alpha2 changes"
+
+// BucketQuota limits the number of BucketClaims and BucketAccesses in a Namespace.
+// The COSI Controller does not provision a Bucket for a BucketClaim, or hand off a BucketAccess
+// to a COSI Sidecar, if doing so would exceed any BucketQuota in the Namespace that applies to it.
+type BucketQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty,omitzero"`
+
+	// spec defines the limits of the BucketQuota
+	// +required
+	Spec BucketQuotaSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of BucketQuota
+	// +optional
+	Status BucketQuotaStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketQuotaList contains a list of BucketQuota
+type BucketQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BucketQuota{}, &BucketQuotaList{})
+}
@@ -29,6 +29,12 @@ const (
 	// Bucket when its BucketClaim is being deleted.
 	BucketClaimBeingDeletedAnnotation = `objectstorage.k8.io/bucketclaim-being-deleted`
 
+	// BucketReleasedAnnotation : This annotation is applied by the COSI Controller to a Bucket when
+	// the BucketClaim it is bound to is deleted or no longer exists, and the Bucket is not being
+	// deleted. A released Bucket is retained for administrators to clean up or recover manually.
+	// No new accesses are provisioned for a released Bucket.
+	BucketReleasedAnnotation = `objectstorage.k8s.io/bucket-released`
+
 	// HasBucketAccessReferencesAnnotation : This annotation is applied by the COSI Controller to a
 	// BucketClaim when a BucketAccess that references the BucketClaim is created. The annotation
 	// remains for as long as any BucketAccess references the BucketClaim. Once all BucketAccesses
@@ -41,12 +47,106 @@ const (
 	// by setting this annotation on the resource.
 	SidecarCleanupFinishedAnnotation = `objectstorage.k8s.io/sidecar-cleanup-finished`
 
+	// AccessSecretDataHashAnnotation : This annotation is applied by a COSI Sidecar to a BucketAccess
+	// access Secret when the Sidecar populates the Secret's data. The value is a hash of the data
+	// written by the Sidecar, which allows the Sidecar to distinguish its own writes from changes
+	// made by others. When the Secret's data no longer matches the hash, the Sidecar re-populates it.
+	AccessSecretDataHashAnnotation = `objectstorage.k8s.io/access-secret-data-hash`
+
+	// RotateCredentialsAnnotation : This annotation can be applied to a BucketAccess by users or
+	// administrators to request that the access credentials be rotated. The COSI Sidecar moves the
+	// request into the BucketAccess status and removes the annotation, then asks the driver to rotate
+	// the credentials and updates the access Secrets. Requests made while a rotation is pending are
+	// handled by the pending rotation.
+	RotateCredentialsAnnotation = `objectstorage.k8s.io/rotate-credentials`
+
 	// ControllerManagementOverrideAnnotation : This annotation can be applied to a resource by the
 	// COSI Controller in order to reclaim management of the resource temporarily when it would
 	// otherwise be managed by a COSI Sidecar. This is intended for scenarios where a bug in
 	// provisioning needs to be rectified by a newer version of the COSI Controller. Once the bug is
 	// resolved, the annotation should be removed to allow normal Sidecar handoff to occur.
 	ControllerManagementOverrideAnnotation = `objectstorage.k8s.io/controller-management-override`
+
+	// TraceContextAnnotation : This annotation is applied by the COSI Controller to a Bucket or
+	// BucketAccess when tracing is enabled and the resource is handed off to a COSI Sidecar. The
+	// value is a W3C Trace Context `traceparent` that the Sidecar uses to continue the trace.
+	TraceContextAnnotation = `objectstorage.k8s.io/trace-context`
+
+	// IsDefaultClassAnnotation : This annotation can be applied to a BucketClass or
+	// BucketAccessClass by administrators to mark it as the default class. When the COSI
+	// Controller's webhooks are enabled, BucketClaims that specify neither bucketClassName nor
+	// existingBucketName use the default BucketClass, and BucketAccesses that don't specify
+	// bucketAccessClassName use the default BucketAccessClass. The annotation value must be
+	// "true". Creating a resource that needs a default fails if more than one class is the default.
+	IsDefaultClassAnnotation = `objectstorage.k8s.io/is-default-class`
+
+	// QuotaReservedAnnotation : This annotation is applied by the COSI Controller to a BucketClaim
+	// or BucketAccess before it reserves BucketQuota usage for the resource. BucketQuota usage
+	// calculation counts resources with the annotation, so usage reserved for a resource that is
+	// not yet provisioned is not lost. The annotation is removed if provisioning fails and the
+	// reserved usage is released.
+	QuotaReservedAnnotation = `objectstorage.k8s.io/quota-reserved`
+)
+
+// Condition types
+const (
+	// ConditionReady indicates that a resource is ready for consumption by workloads. It is True
+	// when status.readyToUse is true. Otherwise, it reports the reason the resource is not ready.
+	ConditionReady = `Ready`
+
+	// ConditionBound indicates that a BucketClaim is bound to a Bucket, that a Bucket is bound to
+	// an existing BucketClaim, or that a BucketAccess has resolved the Buckets it references.
+	ConditionBound = `Bound`
+
+	// ConditionProvisioned indicates that the backend bucket for a Bucket or BucketClaim has been
+	// provisioned by the driver.
+	ConditionProvisioned = `Provisioned`
+
+	// ConditionAccessGranted indicates that the driver has granted access for a BucketAccess.
+	ConditionAccessGranted = `AccessGranted`
+
+	// ConditionDeletionBlocked is True when a deleting resource is unable to finish deletion. The
+	// reason and message describe what is blocking deletion.
+	ConditionDeletionBlocked = `DeletionBlocked`
+)
+
+// Condition reasons
+const (
+	// ReasonSucceeded is the reason for a condition that is True because an operation succeeded.
+	ReasonSucceeded = `Succeeded`
+
+	// ReasonPending is the reason for a condition that is False because the resource is waiting
+	// for something to happen, without any error.
+	ReasonPending = `Pending`
+
+	// ReasonDeleting is the reason for a Ready condition that is False because the resource is
+	// being deleted.
+	ReasonDeleting = `Deleting`
+
+	// ReasonReleased is the reason for a Bound condition that is False because the BucketClaim a
+	// Bucket was bound to no longer exists.
+	ReasonReleased = `Released`
+
+	// ReasonBucketAccessesExist is the reason for a DeletionBlocked condition when a BucketClaim is
+	// waiting for BucketAccesses that reference it to be deleted.
+	ReasonBucketAccessesExist = `BucketAccessesExist`
+
+	// ReasonNamespaceNotAllowed is the reason for a condition that is False because the resource's
+	// class may not be used from the resource's Namespace. See the class's allowedNamespaces.
+	ReasonNamespaceNotAllowed = `NamespaceNotAllowed`
+
+	// ReasonQuotaExceeded is the reason for a condition that is False because provisioning the
+	// resource would exceed a BucketQuota in the resource's Namespace.
+	ReasonQuotaExceeded = `QuotaExceeded`
+
+	// ReasonReconcileError is the reason for a condition that is False because of an error that
+	// does not have a more specific reason.
+	ReasonReconcileError = `ReconcileError`
+
+	// ReasonDriverErrorPrefix prefixes the name of the gRPC status code returned by a driver RPC
+	// to form the reason for a condition that is False because of a driver error.
+	// e.g., DriverInvalidArgument, DriverUnavailable.
+	ReasonDriverErrorPrefix = `Driver`
 )
 
 // Sidecar RPC definitions
@@ -55,6 +155,20 @@ const (
 	RpcEndpointDefault = "unix:///var/lib/cosi/cosi.sock"
 
 	// RpcEndpointEnvVarName is the name of the environment variable that is expected to hold the
-	// RPC endpoint unix socket location. If unspecified, RpcEndpointDefault should be used.
+	// RPC endpoint location. If unspecified, RpcEndpointDefault should be used.
+	// The endpoint is usually a unix socket ("unix://" prefix). The Sidecar also supports network
+	// endpoints ("dns:///" or "tcp://" prefix), which require mutual TLS.
 	RpcEndpointEnvVarName = "COSI_ENDPOINT"
+
+	// RpcTLSCAFileEnvVarName is the name of the environment variable that holds the path of the CA
+	// certificate bundle used by the Sidecar to verify a network RPC endpoint's serving certificate.
+	RpcTLSCAFileEnvVarName = "COSI_ENDPOINT_TLS_CA_FILE"
+
+	// RpcTLSCertFileEnvVarName is the name of the environment variable that holds the path of the
+	// client certificate presented by the Sidecar to a network RPC endpoint.
+	RpcTLSCertFileEnvVarName = "COSI_ENDPOINT_TLS_CERT_FILE"
+
+	// RpcTLSKeyFileEnvVarName is the name of the environment variable that holds the path of the
+	// private key for the Sidecar's client certificate.
+	RpcTLSKeyFileEnvVarName = "COSI_ENDPOINT_TLS_KEY_FILE"
 )
@@ -21,6 +21,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -166,6 +167,21 @@ func (in *BucketAccessClassSpec) DeepCopyInto(out *BucketAccessClassSpec) {
 		*out = make([]BucketAccessMode, len(*in))
 		copy(*out, *in)
 	}
+	if in.CredentialRotationPeriod != nil {
+		in, out := &in.CredentialRotationPeriod, &out.CredentialRotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CredentialRotationGracePeriod != nil {
+		in, out := &in.CredentialRotationGracePeriod, &out.CredentialRotationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAccessClassSpec.
@@ -238,6 +254,13 @@ func (in *BucketAccessStatus) DeepCopyInto(out *BucketAccessStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RetiringAccounts != nil {
+		in, out := &in.RetiringAccounts, &out.RetiringAccounts
+		*out = make([]RetiringAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AccessedBuckets != nil {
 		in, out := &in.AccessedBuckets, &out.AccessedBuckets
 		*out = make([]AccessedBucket, len(*in))
@@ -250,11 +273,36 @@ func (in *BucketAccessStatus) DeepCopyInto(out *BucketAccessStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.CredentialRotationPeriod != nil {
+		in, out := &in.CredentialRotationPeriod, &out.CredentialRotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CredentialRotationGracePeriod != nil {
+		in, out := &in.CredentialRotationGracePeriod, &out.CredentialRotationGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LastCredentialRotationTime != nil {
+		in, out := &in.LastCredentialRotationTime, &out.LastCredentialRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CredentialsExpiryTime != nil {
+		in, out := &in.CredentialsExpiryTime, &out.CredentialsExpiryTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Error != nil {
 		in, out := &in.Error, &out.Error
 		*out = new(TimestampedError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketAccessStatus.
@@ -394,6 +442,13 @@ func (in *BucketClaimStatus) DeepCopyInto(out *BucketClaimStatus) {
 		*out = new(TimestampedError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClaimStatus.
@@ -474,6 +529,11 @@ func (in *BucketClassSpec) DeepCopyInto(out *BucketClassSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.AllowedNamespaces != nil {
+		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketClassSpec.
@@ -518,6 +578,122 @@ func (in *BucketList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuota) DeepCopyInto(out *BucketQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuota.
+func (in *BucketQuota) DeepCopy() *BucketQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuotaCounts) DeepCopyInto(out *BucketQuotaCounts) {
+	*out = *in
+	if in.BucketClaims != nil {
+		in, out := &in.BucketClaims, &out.BucketClaims
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BucketAccesses != nil {
+		in, out := &in.BucketAccesses, &out.BucketAccesses
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuotaCounts.
+func (in *BucketQuotaCounts) DeepCopy() *BucketQuotaCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuotaCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuotaList) DeepCopyInto(out *BucketQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BucketQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuotaList.
+func (in *BucketQuotaList) DeepCopy() *BucketQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BucketQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuotaSpec) DeepCopyInto(out *BucketQuotaSpec) {
+	*out = *in
+	in.Hard.DeepCopyInto(&out.Hard)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuotaSpec.
+func (in *BucketQuotaSpec) DeepCopy() *BucketQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BucketQuotaStatus) DeepCopyInto(out *BucketQuotaStatus) {
+	*out = *in
+	in.Used.DeepCopyInto(&out.Used)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketQuotaStatus.
+func (in *BucketQuotaStatus) DeepCopy() *BucketQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BucketQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
@@ -571,6 +747,13 @@ func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 		*out = new(TimestampedError)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -583,6 +766,22 @@ func (in *BucketStatus) DeepCopy() *BucketStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetiringAccount) DeepCopyInto(out *RetiringAccount) {
+	*out = *in
+	in.RevokeAfter.DeepCopyInto(&out.RevokeAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetiringAccount.
+func (in *RetiringAccount) DeepCopy() *RetiringAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(RetiringAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TimestampedError) DeepCopyInto(out *TimestampedError) {
 	*out = *in
@@ -17,9 +17,11 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -31,11 +33,27 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
 	reconciler "sigs.k8s.io/container-object-storage-interface/controller/pkg/reconciler"
+	cosiwebhook "sigs.k8s.io/container-object-storage-interface/controller/pkg/webhook"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
+)
+
+const (
+	// eventRecorderName is the reporting controller for Events recorded by the COSI Controller.
+	eventRecorderName = "cosi-controller"
+
+	// tracingServiceName is the OpenTelemetry service name for traces from the COSI Controller.
+	tracingServiceName = "cosi-controller"
+
+	// tracingShutdownTimeout limits how long exiting waits for remaining spans to be exported.
+	tracingShutdownTimeout = 5 * time.Second
 )
 
 var (
@@ -53,10 +71,14 @@ func init() {
 func main() {
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
+	var enableWebhooks bool
+	var webhookCertPath, webhookCertName, webhookCertKey string
+	var webhookValidationMode string
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var tracingConfig cositracing.Config
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -72,6 +94,15 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the defaulting and validating admission webhook server is started.")
+	flag.StringVar(&webhookCertPath, "webhook-cert-path", "",
+		"The directory that contains the webhook server certificate.")
+	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook server certificate file.")
+	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook server key file.")
+	flag.StringVar(&webhookValidationMode, "webhook-validation-mode", string(cosiwebhook.ValidationModeDeny),
+		"How validating webhooks report invalid resources: Deny rejects them, and Warn admits them with warnings.")
+	tracingConfig.BindFlags(flag.CommandLine)
 	opts := zap.Options{
 		Development: true,
 	}
@@ -125,9 +156,39 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	validationMode, err := cosiwebhook.ParseValidationMode(webhookValidationMode)
+	if err != nil {
+		setupLog.Error(err, "invalid webhook validation mode")
+		os.Exit(1)
+	}
+
+	webhookServerOptions := webhook.Options{
+		TLSOpts: tlsOpts,
+	}
+
+	// If the certificate is not specified, controller-runtime looks for certificates in its
+	// default directory. Webhook certificates must be trusted by the API server.
+	if len(webhookCertPath) > 0 {
+		setupLog.Info("Initializing webhook certificate watcher using provided certificates",
+			"webhook-cert-path", webhookCertPath, "webhook-cert-name", webhookCertName, "webhook-cert-key", webhookCertKey)
+
+		webhookServerOptions.CertDir = webhookCertPath
+		webhookServerOptions.CertName = webhookCertName
+		webhookServerOptions.KeyName = webhookCertKey
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+
+	shutdownTracing, err := cositracing.Setup(ctx, tracingServiceName, tracingConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
+		WebhookServer:          webhook.NewServer(webhookServerOptions),
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "cosi-controller-leader",
@@ -148,27 +209,71 @@ func main() {
 		os.Exit(1)
 	}
 
+	ctrlmetrics.Registry.MustRegister(cosimetrics.NewObjectCollector(mgr.GetCache(), ctrl.Log.WithName("metrics")))
+
 	if err := (&reconciler.BucketClaimReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorder(eventRecorderName),
+		APIReader: mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BucketClaim")
 		os.Exit(1)
 	}
 	if err := (&reconciler.BucketReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorder(eventRecorderName),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Bucket")
 		os.Exit(1)
 	}
 	if err := (&reconciler.BucketAccessReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorder(eventRecorderName),
+		APIReader: mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "BucketAccess")
 		os.Exit(1)
 	}
+	if err := (&reconciler.BucketQuotaReconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		APIReader: mgr.GetAPIReader(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "BucketQuota")
+		os.Exit(1)
+	}
+
+	if enableWebhooks {
+		if err := (&cosiwebhook.BucketClaimDefaulter{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "BucketClaim defaulting")
+			os.Exit(1)
+		}
+		if err := (&cosiwebhook.BucketAccessDefaulter{
+			Client: mgr.GetClient(),
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "BucketAccess defaulting")
+			os.Exit(1)
+		}
+		if err := (&cosiwebhook.BucketClaimValidator{
+			Client: mgr.GetClient(),
+			Mode:   validationMode,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "BucketClaim")
+			os.Exit(1)
+		}
+		if err := (&cosiwebhook.BucketAccessValidator{
+			Client: mgr.GetClient(),
+			Mode:   validationMode,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "BucketAccess")
+			os.Exit(1)
+		}
+	}
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
@@ -178,10 +283,25 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		setupLog.Error(err, "problem running manager")
+	startErr := mgr.Start(ctx)
+
+	// The manager context is done, so use a new context to flush remaining spans.
+	flushCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if err := shutdownTracing(flushCtx); err != nil {
+		setupLog.Error(err, "failed to shut down tracing")
+	}
+	cancel()
+
+	if startErr != nil {
+		setupLog.Error(startErr, "problem running manager")
 		os.Exit(1)
 	}
 }
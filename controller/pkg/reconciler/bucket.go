@@ -18,19 +18,42 @@ package reconciler
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/go-logr/logr"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
+	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosievents "sigs.k8s.io/container-object-storage-interface/internal/events"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
+	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 )
 
-// BucketReconciler reconciles a Bucket object
+// BucketReconciler reconciles a Bucket object.
+// Buckets are provisioned and deleted by COSI Sidecars. The Controller only manages orphaned
+// Buckets: Buckets bound to a BucketClaim that no longer exists.
 type BucketReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Recorder records Events about reconciled resources. If nil, no Events are recorded.
+	Recorder events.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets,verbs=get;list;watch;create;update;patch;delete
@@ -39,25 +62,215 @@ type BucketReconciler struct {
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
-// TODO(user): Modify the Reconcile function to compare the state specified by
-// the Bucket object against the actual cluster state, and then
-// perform operations to make the cluster state reflect the state specified by
-// the user.
-//
-// For more details, check Reconcile and its Result here:
-// - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.21.0/pkg/reconcile
 func (r *BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	_ = logf.FromContext(ctx)
+	logger := ctrl.LoggerFrom(ctx)
+
+	bucket := &cosiapi.Bucket{}
+	if err := r.Get(ctx, req.NamespacedName, bucket); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.V(1).Info("not reconciling nonexistent Bucket")
+			return ctrl.Result{}, nil
+		}
+		// no resource to add status to or report an event for
+		logger.Error(err, "failed to get Bucket")
+		return ctrl.Result{}, err
+	}
+
+	ctx, span := cositracing.StartReconcile(ctx, cosimetrics.KindBucket, bucket, bucket.Status.ReadyToUse)
+	defer span.End()
+
+	err := r.reconcile(ctx, logger, bucket)
+	cositracing.RecordError(span, err)
+	if err != nil {
+		// Because the Bucket status is primarily managed by the Sidecar, indicate that this error
+		// is coming from the Controller.
+		err = fmt.Errorf("COSI Controller error: %w", err)
 
-	// TODO(user): your logic here
+		// Record any error as a timestamped error in the status.
+		if bucket.Status.ReadyToUse == nil {
+			bucket.Status.ReadyToUse = ptr.To(false)
+		}
+		bucket.Status.Error = cosiapi.NewTimestampedError(time.Now(), err.Error())
+		// The Controller owns the Bound condition. The Sidecar owns provisioning conditions.
+		cosiconditions.SetReconcileError(bucket, &bucket.Status.Conditions, cosiapi.ConditionBound,
+			bucket.Status.ReadyToUse, err)
+		cosievents.ReconcileError(r.Recorder, bucket, err)
+		if updErr := r.Status().Update(ctx, bucket); updErr != nil {
+			logger.Error(err, "failed to update Bucket status after reconcile error", "updateError", updErr)
+			// If status update fails, we must retry the error regardless of the reconcile return.
+			// The reconcile needs to run again to make sure the status is eventually updated.
+			return reconcile.Result{}, err
+		}
 
-	return ctrl.Result{}, nil
+		if errors.Is(err, cosierr.NonRetryableError(nil)) {
+			cosimetrics.CountNonRetryableError(cosimetrics.KindBucket, bucket.Spec.DriverName)
+			return reconcile.Result{}, reconcile.TerminalError(err)
+		}
+		return reconcile.Result{}, err
+	}
+
+	// NOTE: Do not clear the error in the status on success. The Sidecar manages the status of
+	// Buckets that are not orphaned.
+
+	return reconcile.Result{}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *BucketReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&objectstoragev1alpha2.Bucket{}).
+		For(&cosiapi.Bucket{},
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					// reconcile all Buckets on Create/Generic to find orphans left behind while down
+					cosipredicate.AnyCreate(),
+					cosipredicate.AnyGeneric(),
+					// opt in to desired Update events
+					cosipredicate.GenerationChangedInUpdateOnly(), // reconcile spec changes (e.g., deletionPolicy)
+				),
+			),
+		).
+		Watches(
+			&cosiapi.BucketClaim{},
+			handler.EnqueueRequestsFromMapFunc(bucketsForBucketClaim),
+			builder.WithPredicates(cosipredicate.AnyDelete()), // Bucket may be orphaned once claim is gone
+		).
 		Named("bucket").
 		Complete(r)
 }
+
+func (r *BucketReconciler) reconcile(ctx context.Context, logger logr.Logger, bucket *cosiapi.Bucket) error {
+	if !bucket.GetDeletionTimestamp().IsZero() {
+		// Deletion cleanup is performed by the Sidecar.
+		logger.V(1).Info("not reconciling deleting Bucket")
+		return nil
+	}
+
+	claimRef := bucket.Spec.BucketClaimRef
+	if claimRef.UID == "" {
+		// A static Bucket that has not (yet) been bound can't be orphaned.
+		logger.V(1).Info("not reconciling Bucket that is not bound to a BucketClaim")
+		return nil
+	}
+
+	logger = logger.WithValues("bucketClaimNamespace", claimRef.Namespace, "bucketClaimName", claimRef.Name)
+
+	orphanReason, err := r.orphanReason(ctx, logger, bucket)
+	if err != nil {
+		return err
+	}
+	if orphanReason == "" {
+		logger.V(1).Info("Bucket is not orphaned")
+		if !apimeta.IsStatusConditionTrue(bucket.Status.Conditions, cosiapi.ConditionBound) {
+			cosiconditions.SetSucceeded(bucket, &bucket.Status.Conditions, cosiapi.ConditionBound)
+			cosiconditions.SetReady(bucket, &bucket.Status.Conditions, bucket.Status.ReadyToUse)
+			if err := r.Status().Update(ctx, bucket); err != nil {
+				logger.Error(err, "failed to update Bucket Bound condition")
+				return fmt.Errorf("failed to update Bucket Bound condition: %w", err)
+			}
+			cosievents.Normal(r.Recorder, bucket, nil, cosievents.ReasonBound, cosievents.ActionBind,
+				"bound to BucketClaim %s/%s", claimRef.Namespace, claimRef.Name)
+		}
+		return nil
+	}
+	logger = logger.WithValues("orphanReason", orphanReason)
+
+	isStaticProvisioning := bucket.Spec.ExistingBucketID != ""
+	if !isStaticProvisioning && bucket.Spec.DeletionPolicy == cosiapi.BucketDeletionPolicyDelete {
+		// The Sidecar deletes the backend bucket as part of Bucket deletion.
+		logger.Info("deleting orphaned Bucket")
+		if err := r.Delete(ctx, bucket); err != nil && !kerrors.IsNotFound(err) {
+			logger.Error(err, "failed to delete orphaned Bucket")
+			return fmt.Errorf("failed to delete orphaned Bucket: %w", err)
+		}
+		cosievents.Normal(r.Recorder, bucket, nil, cosievents.ReasonOrphaned, cosievents.ActionDelete,
+			"deleting orphaned Bucket: %s", orphanReason)
+		return nil
+	}
+
+	// Statically-provisioned Buckets are created by administrators, and Retain means that
+	// administrators want to keep the Bucket. Leave it to them to decide what to do.
+	if err := markBucketReleased(ctx, logger, r.Client, bucket); err != nil {
+		return err
+	}
+
+	// Report the released state as a non-retryable error so that it is recorded in the status.
+	// The Bucket can't be used again unless an administrator intervenes.
+	bucket.Status.ReadyToUse = ptr.To(false)
+	//nolint:staticcheck // ST1005: okay to capitalize resource kind
+	return cosierr.NonRetryableError(
+		cosiconditions.WithReason(cosiapi.ReasonReleased, fmt.Errorf("Bucket is released: %s", orphanReason)))
+}
+
+// Mark a Bucket that is not being deleted as released, if it isn't already.
+func markBucketReleased(ctx context.Context, logger logr.Logger, c client.Client, bucket *cosiapi.Bucket) error {
+	if !bucket.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+	if _, ok := bucket.Annotations[cosiapi.BucketReleasedAnnotation]; ok {
+		return nil
+	}
+
+	logger.Info("marking Bucket as released")
+	if bucket.Annotations == nil {
+		bucket.Annotations = map[string]string{}
+	}
+	bucket.Annotations[cosiapi.BucketReleasedAnnotation] = ""
+	if err := c.Update(ctx, bucket); err != nil {
+		logger.Error(err, "failed to mark Bucket as released")
+		return fmt.Errorf("failed to mark Bucket as released: %w", err)
+	}
+	return nil
+}
+
+// Determine whether the Bucket is orphaned. An orphaned Bucket is bound to a BucketClaim that no
+// longer exists. Returns a reason message if the Bucket is orphaned, or empty string if not.
+func (r *BucketReconciler) orphanReason(
+	ctx context.Context, logger logr.Logger, bucket *cosiapi.Bucket,
+) (string, error) {
+	claimRef := bucket.Spec.BucketClaimRef
+
+	// Bucket creation and binding happen only after the BucketClaim is observed in the same
+	// informer cache, so the cache can't miss the bound BucketClaim due to lagging behind.
+	claim := &cosiapi.BucketClaim{}
+	claimNsName := types.NamespacedName{
+		Namespace: claimRef.Namespace,
+		Name:      claimRef.Name,
+	}
+	if err := r.Get(ctx, claimNsName, claim); err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Sprintf("bound BucketClaim %q no longer exists", claimNsName.String()), nil
+		}
+		logger.Error(err, "failed to get bound BucketClaim")
+		return "", fmt.Errorf("failed to get bound BucketClaim: %w", err)
+	}
+
+	if claim.UID != claimRef.UID {
+		// The bound BucketClaim was deleted, and a new one was created with the same name.
+		return fmt.Sprintf("BucketClaim %q UID %q does not match bound UID %q",
+			claimNsName.String(), claim.UID, claimRef.UID), nil
+	}
+
+	return "", nil
+}
+
+// Map a BucketClaim to the Bucket(s) it may be bound to.
+func bucketsForBucketClaim(ctx context.Context, obj client.Object) []reconcile.Request {
+	claim, ok := obj.(*cosiapi.BucketClaim)
+	if !ok {
+		return nil
+	}
+
+	name, err := determineBucketName(claim)
+	if err != nil {
+		// A degraded BucketClaim's boundBucketName doesn't match the determined name. Either could
+		// be an orphan, but the bound one is the most likely to exist.
+		name = claim.Status.BoundBucketName
+	}
+	if name == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: name}},
+	}
+}
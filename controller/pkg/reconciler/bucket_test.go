@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	controller "sigs.k8s.io/container-object-storage-interface/controller/pkg/reconciler"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+)
+
+func TestBucketReconcile(t *testing.T) {
+	claim := cositest.OpinionatedS3BucketClaim("my-ns", "my-claim")
+
+	// Bucket bound to the above claim
+	baseBucket := cosiapi.Bucket{
+		ObjectMeta: meta.ObjectMeta{
+			Name:       "bc-" + string(claim.UID),
+			Finalizers: []string{cosiapi.ProtectionFinalizer},
+		},
+		Spec: cosiapi.BucketSpec{
+			DriverName:     "s3.cosi.test",
+			DeletionPolicy: cosiapi.BucketDeletionPolicyDelete,
+			Protocols:      []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolS3},
+			BucketClaimRef: cosiapi.BucketClaimReference{
+				Name:      claim.Name,
+				Namespace: claim.Namespace,
+				UID:       claim.UID,
+			},
+		},
+		Status: cosiapi.BucketStatus{
+			ReadyToUse: ptr.To(true),
+			BucketID:   "cosi-bucket",
+			Protocols:  []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolS3},
+		},
+	}
+
+	recreatedClaim := claim.DeepCopy()
+	recreatedClaim.UID = "recreated"
+
+	tests := []struct {
+		name string
+		// modifies the base bucket
+		modify func(*cosiapi.Bucket)
+		// other objects that should exist
+		objects []client.Object
+		// expected results
+		wantDeleted  bool
+		wantReleased bool
+		wantErr      string
+	}{
+		{"bound claim exists",
+			func(b *cosiapi.Bucket) {},
+			[]client.Object{claim.DeepCopy()},
+			false, false, "",
+		},
+		{"static, not yet bound",
+			func(b *cosiapi.Bucket) {
+				b.Spec.ExistingBucketID = "cosi-bucket"
+				b.Spec.BucketClaimRef.UID = ""
+			},
+			[]client.Object{},
+			false, false, "",
+		},
+		{"dynamic, Delete policy, claim missing",
+			func(b *cosiapi.Bucket) {},
+			[]client.Object{},
+			true, false, "",
+		},
+		{"dynamic, Delete policy, claim UID mismatch",
+			func(b *cosiapi.Bucket) {},
+			[]client.Object{recreatedClaim.DeepCopy()},
+			true, false, "",
+		},
+		{"dynamic, Retain policy, claim missing",
+			func(b *cosiapi.Bucket) { b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyRetain },
+			[]client.Object{},
+			false, true, "no longer exists",
+		},
+		{"static, Delete policy, claim missing",
+			func(b *cosiapi.Bucket) { b.Spec.ExistingBucketID = "cosi-bucket" },
+			[]client.Object{},
+			false, true, "no longer exists",
+		},
+		{"static, Retain policy, claim UID mismatch",
+			func(b *cosiapi.Bucket) {
+				b.Spec.ExistingBucketID = "cosi-bucket"
+				b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyRetain
+			},
+			[]client.Object{recreatedClaim.DeepCopy()},
+			false, true, "does not match bound UID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := baseBucket.DeepCopy()
+			tt.modify(b)
+			bootstrapped := cositest.MustBootstrap(t, append(tt.objects, b)...)
+			ctx := bootstrapped.ContextWithLogger
+
+			r := controller.BucketReconciler{
+				Client: bootstrapped.Client,
+				Scheme: bootstrapped.Client.Scheme(),
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(b)})
+			assert.Empty(t, res)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+			} else {
+				assert.NoError(t, err)
+			}
+
+			bucket := &cosiapi.Bucket{}
+			err = r.Get(ctx, cositest.NsName(b), bucket)
+			require.NoError(t, err) // protection finalizer keeps Bucket around for Sidecar cleanup
+			assert.Equal(t, tt.wantDeleted, !bucket.DeletionTimestamp.IsZero())
+			assert.Equal(t, b.Spec, bucket.Spec)
+
+			if !tt.wantReleased {
+				assert.NotContains(t, bucket.Annotations, cosiapi.BucketReleasedAnnotation)
+				if tt.name == "bound claim exists" {
+					assert.True(t, apimeta.IsStatusConditionTrue(bucket.Status.Conditions, cosiapi.ConditionBound))
+				}
+				bucket.Status.Conditions = nil
+				assert.Equal(t, b.Status, bucket.Status)
+				return
+			}
+			assert.Contains(t, bucket.Annotations, cosiapi.BucketReleasedAnnotation)
+			assert.False(t, *bucket.Status.ReadyToUse)
+			assert.Equal(t, "cosi-bucket", bucket.Status.BucketID)
+			require.NotNil(t, bucket.Status.Error)
+			assert.Contains(t, *bucket.Status.Error.Message, tt.wantErr)
+			bound := apimeta.FindStatusCondition(bucket.Status.Conditions, cosiapi.ConditionBound)
+			require.NotNil(t, bound)
+			assert.Equal(t, meta.ConditionFalse, bound.Status)
+			assert.Equal(t, cosiapi.ReasonReleased, bound.Reason)
+			ready := apimeta.FindStatusCondition(bucket.Status.Conditions, cosiapi.ConditionReady)
+			require.NotNil(t, ready)
+			assert.Equal(t, cosiapi.ReasonReleased, ready.Reason)
+		})
+	}
+
+	t.Run("nonexistent bucket", func(t *testing.T) {
+		bootstrapped := cositest.MustBootstrap(t)
+		ctx := bootstrapped.ContextWithLogger
+
+		r := controller.BucketReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseBucket)})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		err = r.Get(ctx, cositest.NsName(&baseBucket), &cosiapi.Bucket{})
+		assert.True(t, kerrors.IsNotFound(err))
+	})
+}
@@ -24,31 +24,51 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
 	"sigs.k8s.io/container-object-storage-interface/internal/bucketaccess"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
 	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosievents "sigs.k8s.io/container-object-storage-interface/internal/events"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
 	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 )
 
 // BucketAccessReconciler reconciles a BucketAccess object
 type BucketAccessReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Recorder records Events about reconciled resources. If nil, no Events are recorded.
+	Recorder events.EventRecorder
+
+	// APIReader reads directly from the API server when reserving BucketQuota usage. If nil,
+	// Client is used.
+	APIReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses/status,verbs=get;update
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses/finalizers,verbs=update
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketclaims,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccessclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas/status,verbs=get;update
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -71,7 +91,11 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, nil
 	}
 
+	ctx, span := cositracing.StartReconcile(ctx, cosimetrics.KindBucketAccess, access, access.Status.ReadyToUse)
+	defer span.End()
+
 	err := r.reconcile(ctx, logger, access)
+	cositracing.RecordError(span, err)
 	if err != nil {
 		// Because the BucketAccess status is could be managed by either Sidecar or Controller,
 		// indicate that this error is coming from the Controller.
@@ -82,6 +106,10 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			access.Status.ReadyToUse = ptr.To(false)
 		}
 		access.Status.Error = cosiapi.NewTimestampedError(time.Now(), err.Error())
+		// The Controller owns the Bound condition. The Sidecar owns the AccessGranted condition.
+		cosiconditions.SetReconcileError(access, &access.Status.Conditions, cosiapi.ConditionBound,
+			access.Status.ReadyToUse, err)
+		cosievents.ReconcileError(r.Recorder, access, err)
 		if updErr := r.Status().Update(ctx, access); updErr != nil {
 			logger.Error(err, "failed to update BucketAccess status after reconcile error", "updateError", updErr)
 			// If status update fails, we must retry the error regardless of the reconcile return.
@@ -90,6 +118,7 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		}
 
 		if errors.Is(err, cosierr.NonRetryableError(nil)) {
+			cosimetrics.CountNonRetryableError(cosimetrics.KindBucketAccess, access.Status.DriverName)
 			return reconcile.Result{}, reconcile.TerminalError(err)
 		}
 		return reconcile.Result{}, err
@@ -104,41 +133,147 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *BucketAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(context.Background(),
+		&cosiapi.BucketAccess{}, bucketaccess.BucketClaimNameIndexKey, bucketaccess.IndexBucketClaimNames)
+	if err != nil {
+		return fmt.Errorf("failed to index BucketAccesses by BucketClaim name: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&cosiapi.BucketAccess{}).
-		Named("bucketaccess").
-		WithEventFilter(
-			ctrlpredicate.And(
-				cosipredicate.BucketAccessManagedByController(r.Scheme), // only opt in to reconciles managed by controller
+		For(&cosiapi.BucketAccess{},
+			builder.WithPredicates(
+				ctrlpredicate.And(
+					cosipredicate.BucketAccessManagedByController(r.Scheme), // only opt in to reconciles managed by controller
+					ctrlpredicate.Or(
+						// when managed by controller, we should reconcile ALL Create/Delete/Generic events
+						cosipredicate.AnyCreate(),
+						cosipredicate.AnyDelete(),
+						cosipredicate.AnyGeneric(),
+						// opt in to desired update events
+						cosipredicate.BucketAccessHandoffOccurred(r.Scheme), // reconcile any handoff change
+						cosipredicate.ProtectionFinalizerRemoved(r.Scheme),  // re-add protection finalizer if removed
+					),
+				),
+			),
+		).
+		Watches(
+			&cosiapi.BucketClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketAccessesForBucketClaim),
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(),                        // BucketClaim may be created after accesses to it
+					cosipredicate.BucketClaimStatusChanged(r.Scheme), // BucketClaim may finish provisioning
+				),
+			),
+		).
+		Watches(
+			&cosiapi.BucketAccessClass{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketAccessesForBucketAccessClass),
+			builder.WithPredicates(
 				ctrlpredicate.Or(
-					// when managed by controller, we should reconcile ALL Create/Delete/Generic events
-					cosipredicate.AnyCreate(),
-					cosipredicate.AnyDelete(),
+					cosipredicate.AnyCreate(), // BucketAccessClass may be created after accesses that use it
 					cosipredicate.AnyGeneric(),
-					// opt in to desired update events
-					cosipredicate.BucketAccessHandoffOccurred(r.Scheme), // reconcile any handoff change
-					cosipredicate.ProtectionFinalizerRemoved(r.Scheme),  // re-add protection finalizer if removed
 				),
 			),
 		).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketAccessesInNamespace),
+			builder.WithPredicates(
+				cosipredicate.LabelsChangedInUpdateOnly(), // Namespace may become allowed by a BucketAccessClass
+			),
+		).
+		Watches(
+			&cosiapi.BucketQuota{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketAccessesInNamespace),
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(),                        // quota may be created after accesses that need it
+					cosipredicate.GenerationChangedInUpdateOnly(),    // quota limits may be raised
+					cosipredicate.BucketQuotaStatusChanged(r.Scheme), // quota usage may decrease
+				),
+			),
+		).
+		Named("bucketaccess").
 		Complete(r)
 }
 
+// Map a BucketClaim to all BucketAccesses in the same namespace that reference it and are managed
+// by the Controller.
+func (r *BucketAccessReconciler) bucketAccessesForBucketClaim(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx).WithValues("bucketClaimNamespace", obj.GetNamespace(), "bucketClaimName", obj.GetName())
+
+	accesses := &cosiapi.BucketAccessList{}
+	if err := r.List(ctx, accesses,
+		client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{bucketaccess.BucketClaimNameIndexKey: obj.GetName()},
+	); err != nil {
+		logger.Error(err, "failed to list BucketAccesses for BucketClaim")
+		return nil
+	}
+
+	return controllerManagedAccessRequests(accesses.Items, func(*cosiapi.BucketAccess) bool { return true })
+}
+
+// Map a BucketAccessClass to all BucketAccesses that reference it by name and are managed by the
+// Controller.
+func (r *BucketAccessReconciler) bucketAccessesForBucketAccessClass(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx).WithValues("bucketAccessClassName", obj.GetName())
+
+	accesses := &cosiapi.BucketAccessList{}
+	if err := r.List(ctx, accesses); err != nil {
+		logger.Error(err, "failed to list BucketAccesses for BucketAccessClass")
+		return nil
+	}
+
+	return controllerManagedAccessRequests(accesses.Items, func(a *cosiapi.BucketAccess) bool {
+		return a.Spec.BucketAccessClassName == obj.GetName()
+	})
+}
+
+// Map a Namespace, or a BucketQuota, to all BucketAccesses in the namespace that are managed by the
+// Controller.
+func (r *BucketAccessReconciler) bucketAccessesInNamespace(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	namespace := namespaceOf(obj)
+	logger := ctrl.LoggerFrom(ctx).WithValues("namespace", namespace)
+
+	accesses := &cosiapi.BucketAccessList{}
+	if err := r.List(ctx, accesses, client.InNamespace(namespace)); err != nil {
+		logger.Error(err, "failed to list BucketAccesses in Namespace")
+		return nil
+	}
+
+	return controllerManagedAccessRequests(accesses.Items, func(*cosiapi.BucketAccess) bool { return true })
+}
+
+// Return reconcile requests for the BucketAccesses that match the filter and that are managed by
+// the Controller. Requests for Sidecar-managed BucketAccesses would be ignored by the reconciler.
+func controllerManagedAccessRequests(
+	accesses []cosiapi.BucketAccess, filter func(*cosiapi.BucketAccess) bool,
+) []reconcile.Request {
+	reqs := []reconcile.Request{}
+	for i := range accesses {
+		a := &accesses[i]
+		if !filter(a) || bucketaccess.ManagedBySidecar(a) {
+			continue
+		}
+		reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(a)})
+	}
+	return reqs
+}
+
 func (r *BucketAccessReconciler) reconcile(
 	ctx context.Context, logger logr.Logger, access *cosiapi.BucketAccess,
 ) error {
 	if !access.GetDeletionTimestamp().IsZero() {
 		logger.V(1).Info("beginning BucketAccess deletion cleanup")
-
-		// TODO: deletion logic
-
-		ctrlutil.RemoveFinalizer(access, cosiapi.ProtectionFinalizer)
-		if err := r.Update(ctx, access); err != nil {
-			logger.Error(err, "failed to remove finalizer")
-			return fmt.Errorf("failed to remove finalizer: %w", err)
-		}
-
-		return cosierr.NonRetryableError(fmt.Errorf("deletion is not yet implemented")) // TODO
+		return r.reconcileDelete(ctx, logger, access)
 	}
 
 	initialized, err := bucketaccess.SidecarRequirementsPresent(&access.Status)
@@ -162,10 +297,18 @@ func (r *BucketAccessReconciler) reconcile(
 	logger.V(1).Info("initializing BucketAccess")
 
 	didAdd := ctrlutil.AddFinalizer(access, cosiapi.ProtectionFinalizer)
-	if didAdd {
+	// Let the Sidecar continue this trace after handoff.
+	didAnnotate := cositracing.InjectAnnotation(ctx, access)
+	if didAdd || didAnnotate {
 		if err := r.Update(ctx, access); err != nil {
-			logger.Error(err, "failed to add protection finalizer")
-			return fmt.Errorf("failed to add protection finalizer: %w", err)
+			msg := "failed to add protection finalizer and trace context annotation"
+			if !didAnnotate {
+				msg = "failed to add protection finalizer"
+			} else if !didAdd {
+				msg = "failed to add trace context annotation"
+			}
+			logger.Error(err, msg)
+			return fmt.Errorf("%s: %w", msg, err)
 		}
 	}
 
@@ -198,10 +341,8 @@ func (r *BucketAccessReconciler) reconcile(
 	}
 	if err := r.Get(ctx, classNsName, class); err != nil {
 		if kerrors.IsNotFound(err) {
-			// TODO: for now, return an error and allow the controller to exponential backoff
-			// until the access class exists. in the future, optimize this by adding a
-			// access class reconciler that enqueues requests for BucketAccesses that reference the
-			// class and aren't yet passed to the sidecar.
+			// The BucketAccessClass watch enqueues this BucketAccess as soon as the class is
+			// created. Until then, backoff retries are a fallback, and the error is visible to the user.
 			logger.Error(err, "BucketAccessClass not found")
 			return err
 		}
@@ -214,6 +355,12 @@ func (r *BucketAccessReconciler) reconcile(
 		return cosierr.NonRetryableError(err)
 	}
 
+	if err := checkNamespaceAllowed(ctx, r.Client, "BucketAccessClass", class.Name,
+		class.Spec.AllowedNamespaces, access.Namespace); err != nil {
+		logger.Error(err, "BucketAccessClass may not be used from BucketAccess namespace")
+		return err
+	}
+
 	bucketsByClaimName, err := getBucketsForClaims(ctx, r.Client, claimsByName)
 	if err != nil {
 		logger.Error(err, "failed to get Buckets for referenced BucketClaims")
@@ -230,9 +377,8 @@ func (r *BucketAccessReconciler) reconcile(
 	waitlist := waitingOnBucketClaims(claimsByName, bucketsByClaimName)
 	if len(waitlist) > 0 {
 		logger.Error(nil, "waiting for prerequisites before provisioning access", "waitlist", waitlist)
-		// TODO: for now, return an error and allow the controller to exponential backoff until we
-		// are done waiting on the resources. in the future, optimize this by adding a bucketclaim
-		// reconciler that enqueues requests for BucketClaims when they finish provisioning.
+		// The BucketClaim watch enqueues this BucketAccess as soon as BucketClaims are created or
+		// finish provisioning. Until then, backoff retries are a fallback.
 		return fmt.Errorf("waiting for prerequisites before provisioning access: %v", waitlist)
 	}
 
@@ -242,6 +388,13 @@ func (r *BucketAccessReconciler) reconcile(
 		return fmt.Errorf("waiting for BucketClaims to finish provisioning: %w", err)
 	}
 
+	reader := apiReaderOrClient(r.APIReader, r.Client)
+	reservation, err := reserveBucketAccessQuota(ctx, r.Client, reader, access)
+	if err != nil {
+		logger.Error(err, "failed to reserve BucketQuota usage for BucketAccess")
+		return err
+	}
+
 	// After this status update, resource management should be handed off to the Sidecar
 	if access.Status.ReadyToUse == nil {
 		access.Status.ReadyToUse = ptr.To(false)
@@ -250,11 +403,56 @@ func (r *BucketAccessReconciler) reconcile(
 	access.Status.DriverName = class.Spec.DriverName
 	access.Status.AuthenticationType = class.Spec.AuthenticationType
 	access.Status.Parameters = class.Spec.Parameters
+	access.Status.CredentialRotationPeriod = class.Spec.CredentialRotationPeriod
+	access.Status.CredentialRotationGracePeriod = class.Spec.CredentialRotationGracePeriod
 	access.Status.Error = nil
+	cosiconditions.SetSucceeded(access, &access.Status.Conditions, cosiapi.ConditionBound)
+	cosiconditions.SetPending(access, &access.Status.Conditions, cosiapi.ConditionAccessGranted,
+		"waiting for the driver to grant access")
+	cosiconditions.SetReady(access, &access.Status.Conditions, access.Status.ReadyToUse)
 	if err := r.Status().Update(ctx, access); err != nil {
+		reservation.release(ctx, r.Client, reader)
 		logger.Error(err, "failed to update BucketClaim status after successful initialization")
 		return err
 	}
+	cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonHandedOff, cosievents.ActionHandoff,
+		"initialized BucketAccess and handed off to the COSI Sidecar for driver %q", class.Spec.DriverName)
+
+	return nil
+}
+
+func (r *BucketAccessReconciler) reconcileDelete(
+	ctx context.Context, logger logr.Logger, access *cosiapi.BucketAccess,
+) error {
+	if !ctrlutil.ContainsFinalizer(access, cosiapi.ProtectionFinalizer) {
+		logger.V(1).Info("no BucketAccess deletion cleanup needed")
+		return nil
+	}
+
+	claimsByName, err := getAllBucketClaims(ctx, r.Client, access.Namespace, access.Spec.BucketClaims)
+	if err != nil {
+		logger.Error(err, "failed to get all referenced BucketClaims")
+		return err
+	}
+
+	accesses := &cosiapi.BucketAccessList{}
+	if err := r.List(ctx, accesses, client.InNamespace(access.Namespace)); err != nil {
+		logger.Error(err, "failed to list BucketAccesses")
+		return fmt.Errorf("failed to list BucketAccesses: %w", err)
+	}
+
+	// Unmark BucketClaims as the last step before removing the finalizer. If any unmarking fails,
+	// this BucketAccess's references are still accounted for when the deletion is retried.
+	if err := unmarkUnreferencedBucketClaims(ctx, r.Client, access, accesses.Items, claimsByName); err != nil {
+		logger.Error(err, "failed to unmark BucketClaims with no remaining BucketAccess references")
+		return err
+	}
+
+	ctrlutil.RemoveFinalizer(access, cosiapi.ProtectionFinalizer)
+	if err := r.Update(ctx, access); err != nil {
+		logger.Error(err, "failed to remove finalizer")
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
 
 	return nil
 }
@@ -342,6 +540,56 @@ func markAllBucketClaimsAsAccessed(
 	return nil
 }
 
+// Remove the BucketAccess reference marking from all (non-nil) BucketClaims that are not referenced
+// by any BucketAccess other than the given deleting one. This is the counterpart to
+// markAllBucketClaimsAsAccessed().
+// Other BucketAccesses that are also deleting are still considered references until they are gone.
+func unmarkUnreferencedBucketClaims(
+	ctx context.Context,
+	client client.Client,
+	deleting *cosiapi.BucketAccess,
+	allAccesses []cosiapi.BucketAccess,
+	claimsByName map[string]*cosiapi.BucketClaim,
+) error {
+	stillReferenced := map[string]bool{}
+	for _, a := range allAccesses {
+		if a.Name == deleting.Name {
+			continue
+		}
+		for _, ref := range a.Spec.BucketClaims {
+			stillReferenced[ref.BucketClaimName] = true
+		}
+	}
+
+	errs := []error{}
+	for name, claim := range claimsByName {
+		if claim == nil {
+			continue
+		}
+		if stillReferenced[name] {
+			continue
+		}
+		if _, ok := claim.Annotations[cosiapi.HasBucketAccessReferencesAnnotation]; !ok {
+			continue // already absent
+		}
+		// Race condition: a new BucketAccess referencing this BucketClaim could be created after
+		// BucketAccesses were listed. If the new BucketAccess saw the marking as already present,
+		// it will not re-apply it, and the BucketClaim could be deleted while still referenced.
+		// This is rare enough in the real world that resolving it with a more rigorous reference
+		// counting scheme seems like premature optimization.
+		delete(claim.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+		if err := client.Update(ctx, claim); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to unmark one or more BucketClaims as having a BucketAccess reference: %w",
+			errors.Join(errs...))
+	}
+
+	return nil
+}
+
 // Return an error if the BucketAccess doesn't meet BucketAccessClass requirements.
 func ValidateAccessAgainstClass(
 	class *cosiapi.BucketAccessClassSpec,
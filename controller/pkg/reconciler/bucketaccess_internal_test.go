@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+)
+
+func TestBucketAccessReconciler_watchMapping(t *testing.T) {
+	newAccess := func(namespace, name, className string, claimNames ...string) *cosiapi.BucketAccess {
+		a := &cosiapi.BucketAccess{
+			ObjectMeta: meta.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: cosiapi.BucketAccessSpec{
+				BucketAccessClassName: className,
+			},
+		}
+		for _, n := range claimNames {
+			a.Spec.BucketClaims = append(a.Spec.BucketClaims, cosiapi.BucketClaimAccess{BucketClaimName: n})
+		}
+		return a
+	}
+
+	uninitialized := newAccess("ns1", "uninitialized", "class-a", "claim-1", "claim-2")
+	otherClaim := newAccess("ns1", "other-claim", "class-b", "claim-2")
+	otherNs := newAccess("ns2", "other-ns", "class-a", "claim-1")
+	handedOff := newAccess("ns1", "handed-off", "class-a", "claim-1")
+	handedOff.Status.DriverName = "some.driver.io"
+
+	bootstrapped := cositest.MustBootstrap(t, uninitialized, otherClaim, otherNs, handedOff)
+	ctx := bootstrapped.ContextWithLogger
+	r := BucketAccessReconciler{
+		Client: bootstrapped.Client,
+		Scheme: bootstrapped.Client.Scheme(),
+	}
+
+	t.Run("BucketClaim", func(t *testing.T) {
+		claim := cositest.OpinionatedS3BucketClaim("ns1", "claim-1")
+
+		reqs := r.bucketAccessesForBucketClaim(ctx, claim)
+		assert.ElementsMatch(t,
+			[]reconcile.Request{
+				{NamespacedName: cositest.NsName(uninitialized)},
+			},
+			reqs,
+		)
+	})
+
+	t.Run("BucketAccessClass", func(t *testing.T) {
+		class := &cosiapi.BucketAccessClass{
+			ObjectMeta: meta.ObjectMeta{Name: "class-a"},
+		}
+
+		reqs := r.bucketAccessesForBucketAccessClass(ctx, class)
+		assert.ElementsMatch(t,
+			[]reconcile.Request{
+				{NamespacedName: cositest.NsName(uninitialized)},
+				{NamespacedName: cositest.NsName(otherNs)},
+			},
+			reqs,
+		)
+	})
+}
@@ -18,10 +18,15 @@ package reconciler_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -74,6 +79,8 @@ func TestBucketAccessReconcile(t *testing.T) {
 			// by default, test the more complex multi-bucket cases
 			MultiBucketAccess: cosiapi.MultiBucketAccessMultipleBuckets,
 			// DisallowedBucketAccessModes: unset
+			CredentialRotationPeriod:      &meta.Duration{Duration: 90 * 24 * time.Hour},
+			CredentialRotationGracePeriod: &meta.Duration{Duration: time.Hour},
 		},
 	}
 
@@ -144,6 +151,12 @@ func TestBucketAccessReconcile(t *testing.T) {
 		)
 		assert.Equal(t, "cosi.s3.internal", status.DriverName)
 		assert.Equal(t, "Key", string(status.AuthenticationType))
+		assert.True(t, apimeta.IsStatusConditionTrue(status.Conditions, cosiapi.ConditionBound))
+		granted := apimeta.FindStatusCondition(status.Conditions, cosiapi.ConditionAccessGranted)
+		require.NotNil(t, granted)
+		assert.Equal(t, meta.ConditionFalse, granted.Status)
+		assert.Equal(t, cosiapi.ReasonPending, granted.Reason)
+		assert.True(t, apimeta.IsStatusConditionFalse(status.Conditions, cosiapi.ConditionReady))
 		assert.Equal(t,
 			map[string]string{
 				"maxSize": "100Gi",
@@ -151,6 +164,8 @@ func TestBucketAccessReconcile(t *testing.T) {
 			},
 			status.Parameters,
 		)
+		assert.Equal(t, &meta.Duration{Duration: 90 * 24 * time.Hour}, status.CredentialRotationPeriod)
+		assert.Equal(t, &meta.Duration{Duration: time.Hour}, status.CredentialRotationGracePeriod)
 
 		assert.True(t, bucketaccess.ManagedBySidecar(access))                       // MUST hand off to sidecar
 		initialized, err := bucketaccess.SidecarRequirementsPresent(&access.Status) // MUST be fully initialized
@@ -232,6 +247,11 @@ func TestBucketAccessReconcile(t *testing.T) {
 		assert.NotNil(t, status.Error.Time)
 		assert.NotContains(t, *status.Error.Message, "readwrite-bucket")
 		assert.Contains(t, *status.Error.Message, "readonly-bucket")
+		bound := apimeta.FindStatusCondition(status.Conditions, cosiapi.ConditionBound)
+		require.NotNil(t, bound)
+		assert.Equal(t, meta.ConditionFalse, bound.Status)
+		assert.Equal(t, cosiapi.ReasonReconcileError, bound.Reason)
+		assert.Contains(t, bound.Message, "readonly-bucket")
 		assert.Equal(t, "", status.AccountID)
 		assert.Empty(t, status.AccessedBuckets)
 		assert.Empty(t, status.DriverName)
@@ -569,6 +589,128 @@ func TestBucketAccessReconcile(t *testing.T) {
 		assert.Contains(t, cro.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
 	})
 
+	t.Run("dynamic provisioning, bucketaccessclass does not allow namespace", func(t *testing.T) {
+		class := baseClass.DeepCopy()
+		class.Spec.AllowedNamespaces = &meta.LabelSelector{
+			MatchExpressions: []meta.LabelSelectorRequirement{
+				{Key: "compliance", Operator: meta.LabelSelectorOpExists},
+			},
+		}
+		ns := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{Name: "my-ns"}}
+
+		bootstrapped := cositest.MustBootstrap(t,
+			baseAccess.DeepCopy(),
+			class,
+			ns,
+			baseReadWriteClaim.DeepCopy(),
+			baseReadOnlyClaim.DeepCopy(),
+		)
+		ctx := bootstrapped.ContextWithLogger
+
+		r := controller.BucketAccessReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+		assert.Empty(t, res)
+
+		access := &cosiapi.BucketAccess{}
+		err = r.Get(ctx, cositest.NsName(&baseAccess), access)
+		require.NoError(t, err)
+		status := access.Status
+		assert.False(t, *status.ReadyToUse)
+		require.NotNil(t, status.Error)
+		assert.Contains(t, *status.Error.Message, `BucketAccessClass "s3-class" may not be used from Namespace "my-ns"`)
+		bound := apimeta.FindStatusCondition(status.Conditions, cosiapi.ConditionBound)
+		require.NotNil(t, bound)
+		assert.Equal(t, meta.ConditionFalse, bound.Status)
+		assert.Equal(t, cosiapi.ReasonNamespaceNotAllowed, bound.Reason)
+		assert.Empty(t, status.AccessedBuckets)
+		assert.Empty(t, status.DriverName)
+
+		assert.False(t, bucketaccess.ManagedBySidecar(access)) // MUST NOT hand off to sidecar
+
+		// once the namespace is labeled, the BucketAccess may use the class
+		ns.Labels = map[string]string{"compliance": "pci"}
+		require.NoError(t, r.Update(ctx, ns))
+
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
+		err = r.Get(ctx, cositest.NsName(&baseAccess), access)
+		require.NoError(t, err)
+		assert.NotContains(t, *access.Status.Error.Message, "may not be used from Namespace")
+	})
+
+	t.Run("dynamic provisioning, bucketquota exceeded", func(t *testing.T) {
+		quota := &cosiapi.BucketQuota{
+			ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-quota"},
+			Spec: cosiapi.BucketQuotaSpec{
+				Hard: cosiapi.BucketQuotaCounts{BucketAccesses: ptr.To[int32](1)},
+			},
+			Status: cosiapi.BucketQuotaStatus{
+				Used: cosiapi.BucketQuotaCounts{BucketAccesses: ptr.To[int32](1)},
+			},
+		}
+
+		bootstrapped := cositest.MustBootstrap(t,
+			baseAccess.DeepCopy(),
+			baseClass.DeepCopy(),
+			quota,
+			baseReadWriteClaim.DeepCopy(),
+			baseReadOnlyClaim.DeepCopy(),
+			cositest.OpinionatedS3BucketClass(),
+		)
+		ctx := bootstrapped.ContextWithLogger
+
+		// provision BucketClaims so that the BucketAccess is otherwise ready for handoff
+		for _, claim := range []*cosiapi.BucketClaim{baseReadWriteClaim, baseReadOnlyClaim} {
+			c, err := controllertest.ReconcileBucketClaim(t, bootstrapped, cositest.NsName(claim))
+			require.NoError(t, err)
+			_, err = sidecartest.ReconcileOpinionatedS3Bucket(t, bootstrapped, cositest.BucketNsName(c))
+			require.NoError(t, err)
+			_, err = controllertest.ReconcileBucketClaim(t, bootstrapped, cositest.NsName(claim))
+			require.NoError(t, err)
+		}
+
+		r := controller.BucketAccessReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+		assert.Empty(t, res)
+
+		access := &cosiapi.BucketAccess{}
+		err = r.Get(ctx, cositest.NsName(&baseAccess), access)
+		require.NoError(t, err)
+		status := access.Status
+		require.NotNil(t, status.Error)
+		assert.Contains(t, *status.Error.Message, `exceeded BucketQuota "my-quota": 1 of 1 BucketAccesses are in use`)
+		bound := apimeta.FindStatusCondition(status.Conditions, cosiapi.ConditionBound)
+		require.NotNil(t, bound)
+		assert.Equal(t, cosiapi.ReasonQuotaExceeded, bound.Reason)
+		assert.Empty(t, status.AccessedBuckets)
+		assert.False(t, bucketaccess.ManagedBySidecar(access)) // MUST NOT hand off to sidecar
+
+		// once the quota is raised, the BucketAccess is handed off, and its usage is reserved
+		require.NoError(t, r.Get(ctx, cositest.NsName(quota), quota))
+		quota.Spec.Hard.BucketAccesses = ptr.To[int32](2)
+		require.NoError(t, r.Update(ctx, quota))
+
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.NoError(t, err)
+		err = r.Get(ctx, cositest.NsName(&baseAccess), access)
+		require.NoError(t, err)
+		assert.NotEmpty(t, access.Status.AccessedBuckets)
+		require.NoError(t, r.Get(ctx, cositest.NsName(quota), quota))
+		assert.Equal(t, ptr.To[int32](2), quota.Status.Used.BucketAccesses)
+	})
+
 	t.Run("dynamic provisioning, single-bucket passes when multi-bucket access is disallowed", func(t *testing.T) {
 		access := baseAccess.DeepCopy()
 		access.Spec.BucketClaims = []cosiapi.BucketClaimAccess{
@@ -779,6 +921,123 @@ func TestBucketAccessReconcile(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotContains(t, cro.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
 	})
+
+	// BucketAccess that has been handed off to the Sidecar and referenced BucketClaims marked
+	handedOffAccess := func() *cosiapi.BucketAccess {
+		a := baseAccess.DeepCopy()
+		a.Finalizers = []string{cosiapi.ProtectionFinalizer}
+		a.Status.DriverName = baseClass.Spec.DriverName
+		a.Status.AuthenticationType = baseClass.Spec.AuthenticationType
+		return a
+	}
+	markedClaim := func(c *cosiapi.BucketClaim) *cosiapi.BucketClaim {
+		c = c.DeepCopy()
+		c.Annotations = map[string]string{cosiapi.HasBucketAccessReferencesAnnotation: ""}
+		return c
+	}
+
+	t.Run("deletion, sidecar cleanup not finished", func(t *testing.T) {
+		bootstrapped := cositest.MustBootstrap(t,
+			handedOffAccess(),
+			markedClaim(baseReadWriteClaim),
+			markedClaim(baseReadOnlyClaim),
+		)
+		ctx := bootstrapped.ContextWithLogger
+
+		r := controller.BucketAccessReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		require.NoError(t, r.Delete(ctx, baseAccess.DeepCopy()))
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		access := &cosiapi.BucketAccess{}
+		err = r.Get(ctx, cositest.NsName(&baseAccess), access)
+		require.NoError(t, err)
+		assert.Contains(t, access.GetFinalizers(), cosiapi.ProtectionFinalizer)
+
+		for _, c := range []*cosiapi.BucketClaim{baseReadWriteClaim, baseReadOnlyClaim} {
+			claim := &cosiapi.BucketClaim{}
+			require.NoError(t, r.Get(ctx, cositest.NsName(c), claim))
+			assert.Contains(t, claim.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+		}
+	})
+
+	t.Run("deletion, no other references", func(t *testing.T) {
+		access := handedOffAccess()
+		access.Annotations = map[string]string{cosiapi.SidecarCleanupFinishedAnnotation: ""}
+		bootstrapped := cositest.MustBootstrap(t,
+			access,
+			markedClaim(baseReadWriteClaim),
+			markedClaim(baseReadOnlyClaim),
+		)
+		ctx := bootstrapped.ContextWithLogger
+
+		r := controller.BucketAccessReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		require.NoError(t, r.Delete(ctx, baseAccess.DeepCopy()))
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		err = r.Get(ctx, cositest.NsName(&baseAccess), &cosiapi.BucketAccess{})
+		assert.True(t, kerrors.IsNotFound(err))
+
+		for _, c := range []*cosiapi.BucketClaim{baseReadWriteClaim, baseReadOnlyClaim} {
+			claim := &cosiapi.BucketClaim{}
+			require.NoError(t, r.Get(ctx, cositest.NsName(c), claim))
+			assert.NotContains(t, claim.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+		}
+	})
+
+	t.Run("deletion, other BucketAccess references a claim", func(t *testing.T) {
+		access := handedOffAccess()
+		access.Annotations = map[string]string{cosiapi.SidecarCleanupFinishedAnnotation: ""}
+
+		otherAccess := baseAccess.DeepCopy()
+		otherAccess.Name = "other-access"
+		otherAccess.Spec.BucketClaims = []cosiapi.BucketClaimAccess{
+			baseAccess.DeepCopy().Spec.BucketClaims[0], // readwrite-bucket
+		}
+
+		bootstrapped := cositest.MustBootstrap(t,
+			access,
+			otherAccess,
+			markedClaim(baseReadWriteClaim),
+			markedClaim(baseReadOnlyClaim),
+		)
+		ctx := bootstrapped.ContextWithLogger
+
+		r := controller.BucketAccessReconciler{
+			Client: bootstrapped.Client,
+			Scheme: bootstrapped.Client.Scheme(),
+		}
+
+		require.NoError(t, r.Delete(ctx, baseAccess.DeepCopy()))
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+
+		err = r.Get(ctx, cositest.NsName(&baseAccess), &cosiapi.BucketAccess{})
+		assert.True(t, kerrors.IsNotFound(err))
+
+		crw := &cosiapi.BucketClaim{}
+		require.NoError(t, r.Get(ctx, cositest.NsName(baseReadWriteClaim), crw))
+		assert.Contains(t, crw.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+
+		cro := &cosiapi.BucketClaim{}
+		require.NoError(t, r.Get(ctx, cositest.NsName(baseReadOnlyClaim), cro))
+		assert.NotContains(t, cro.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+	})
 }
 
 func Test_validateAccessAgainstClass(t *testing.T) {
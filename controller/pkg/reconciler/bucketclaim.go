@@ -20,36 +20,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
 	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosievents "sigs.k8s.io/container-object-storage-interface/internal/events"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
 	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 )
 
 // BucketClaimReconciler reconciles a BucketClaim object
 type BucketClaimReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// Recorder records Events about reconciled resources. If nil, no Events are recorded.
+	Recorder events.EventRecorder
+
+	// APIReader reads directly from the API server when reserving BucketQuota usage. If nil,
+	// Client is used.
+	APIReader client.Reader
 }
 
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketclaims,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketclaims/status,verbs=get;update
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketclaims/finalizers,verbs=update
-// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets,verbs=get;list;watch;update;patch;delete
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas/status,verbs=get;update
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -67,13 +90,23 @@ func (r *BucketClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, err
 	}
 
+	ctx, span := cositracing.StartReconcile(ctx, cosimetrics.KindBucketClaim, claim, claim.Status.ReadyToUse)
+	defer span.End()
+
 	err := r.reconcile(ctx, logger, claim)
+	cositracing.RecordError(span, err)
 	if err != nil {
 		// Record any error as a timestamped error in the status.
 		if claim.Status.ReadyToUse == nil {
 			claim.Status.ReadyToUse = ptr.To(false)
 		}
 		claim.Status.Error = cosiapi.NewTimestampedError(time.Now(), err.Error())
+		failedCondition := cosiapi.ConditionBound
+		if claim.Status.BoundBucketName != "" {
+			failedCondition = cosiapi.ConditionProvisioned
+		}
+		cosiconditions.SetReconcileError(claim, &claim.Status.Conditions, failedCondition, claim.Status.ReadyToUse, err)
+		cosievents.ReconcileError(r.Recorder, claim, err)
 		if updErr := r.Status().Update(ctx, claim); updErr != nil {
 			logger.Error(err, "failed to update BucketClaim status after reconcile error", "updateError", updErr)
 			// If status update fails, we must retry the error regardless of the reconcile return.
@@ -82,17 +115,22 @@ func (r *BucketClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 
 		if errors.Is(err, cosierr.NonRetryableError(nil)) {
+			cosimetrics.CountNonRetryableError(cosimetrics.KindBucketClaim, "")
 			return reconcile.Result{}, reconcile.TerminalError(err)
 		}
 		return reconcile.Result{}, err
 	}
 
-	// On success, clear any errors in the status.
-	if claim.Status.Error != nil && !claim.DeletionTimestamp.IsZero() {
+	// On success, clear any errors in the status. This includes success while waiting for the
+	// Bucket to be provisioned, which ends in a Bucket status change rather than a retry.
+	// Without the protection finalizer, a deleting BucketClaim may already be gone.
+	if claim.Status.Error != nil && ctrlutil.ContainsFinalizer(claim, cosiapi.ProtectionFinalizer) {
 		if claim.Status.ReadyToUse == nil {
 			claim.Status.ReadyToUse = ptr.To(false)
 		}
 		claim.Status.Error = nil
+		apimeta.RemoveStatusCondition(&claim.Status.Conditions, cosiapi.ConditionDeletionBlocked)
+		cosiconditions.SetReady(claim, &claim.Status.Conditions, claim.Status.ReadyToUse)
 		if err := r.Status().Update(ctx, claim); err != nil {
 			logger.Error(err, "failed to update BucketClaim status after reconcile success")
 			// Retry the reconcile so status can be updated eventually.
@@ -106,23 +144,137 @@ func (r *BucketClaimReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 // SetupWithManager sets up the controller with the Manager.
 func (r *BucketClaimReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&cosiapi.BucketClaim{}).
-		WithEventFilter(
-			ctrlpredicate.Or( //
-				// this is the only bucketclaim controller and should reconcile ALL Create/Delete/Generic events
-				cosipredicate.AnyCreate(),
-				cosipredicate.AnyDelete(),
-				cosipredicate.AnyGeneric(),
-				// opt in to desired Update events
-				cosipredicate.GenerationChangedInUpdateOnly(),      // reconcile spec changes
-				cosipredicate.ProtectionFinalizerRemoved(r.Scheme), // re-add protection finalizer if removed
+		For(&cosiapi.BucketClaim{},
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					// this is the only bucketclaim controller and should reconcile ALL Create/Delete/Generic events
+					cosipredicate.AnyCreate(),
+					cosipredicate.AnyDelete(),
+					cosipredicate.AnyGeneric(),
+					// opt in to desired Update events
+					cosipredicate.GenerationChangedInUpdateOnly(),      // reconcile spec changes
+					cosipredicate.ProtectionFinalizerRemoved(r.Scheme), // re-add protection finalizer if removed
+					cosipredicate.BucketAccessReferencesRemoved(),      // continue deletion after last access is gone
+				),
+			),
+		).
+		Watches(
+			&cosiapi.Bucket{},
+			handler.EnqueueRequestsFromMapFunc(bucketClaimForBucket),
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(),                   // static Bucket may be created after the claim
+					cosipredicate.AnyDelete(),                   // continue deletion after intermediate Bucket is gone
+					cosipredicate.BucketStatusChanged(r.Scheme), // mirror provisioning results from the Sidecar
+				),
+			),
+		).
+		Watches(
+			&cosiapi.BucketClass{},
+			handler.EnqueueRequestsFromMapFunc(r.unboundBucketClaimsForBucketClass),
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(), // BucketClass may be created after claims that use it
+					cosipredicate.AnyGeneric(),
+				),
 			),
 		).
-		Named("bucketclaim"). // TODO: .Owns(&cosiapi.Bucket{}, builder.WithPredicates(...))
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.unboundBucketClaimsInNamespace),
+			builder.WithPredicates(
+				cosipredicate.LabelsChangedInUpdateOnly(), // Namespace may become allowed by a BucketClass
+			),
+		).
+		Watches(
+			&cosiapi.BucketQuota{},
+			handler.EnqueueRequestsFromMapFunc(r.unboundBucketClaimsInNamespace),
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(),                        // quota may be created after claims that need it
+					cosipredicate.GenerationChangedInUpdateOnly(),    // quota limits may be raised
+					cosipredicate.BucketQuotaStatusChanged(r.Scheme), // quota usage may decrease
+				),
+			),
+		).
+		Named("bucketclaim").
 		Complete(r)
 }
 
+// Map a BucketClass to all dynamically-provisioned BucketClaims that reference it by name and are
+// not yet bound to a Bucket. Bound BucketClaims no longer depend on the BucketClass.
+func (r *BucketClaimReconciler) unboundBucketClaimsForBucketClass(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx).WithValues("bucketClassName", obj.GetName())
+
+	claims := &cosiapi.BucketClaimList{}
+	if err := r.List(ctx, claims); err != nil {
+		logger.Error(err, "failed to list BucketClaims for BucketClass")
+		return nil
+	}
+
+	reqs := []reconcile.Request{}
+	for _, claim := range claims.Items {
+		if claim.Spec.BucketClassName != obj.GetName() {
+			continue
+		}
+		if claim.Spec.ExistingBucketName != "" || claim.Status.BoundBucketName != "" {
+			continue
+		}
+		reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+	}
+	return reqs
+}
+
+// Map a Namespace, or a BucketQuota, to all dynamically-provisioned BucketClaims in the namespace
+// that are not yet bound to a Bucket. Only unbound BucketClaims are checked against BucketClass
+// allowedNamespaces and BucketQuotas.
+func (r *BucketClaimReconciler) unboundBucketClaimsInNamespace(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	namespace := namespaceOf(obj)
+	logger := ctrl.LoggerFrom(ctx).WithValues("namespace", namespace)
+
+	claims := &cosiapi.BucketClaimList{}
+	if err := r.List(ctx, claims, client.InNamespace(namespace)); err != nil {
+		logger.Error(err, "failed to list BucketClaims in Namespace")
+		return nil
+	}
+
+	reqs := []reconcile.Request{}
+	for _, claim := range claims.Items {
+		if claim.Spec.ExistingBucketName != "" || claim.Status.BoundBucketName != "" {
+			continue
+		}
+		reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&claim)})
+	}
+	return reqs
+}
+
+// Map a Bucket to the BucketClaim referenced by its bucketClaimRef.
+func bucketClaimForBucket(ctx context.Context, obj client.Object) []reconcile.Request {
+	bucket, ok := obj.(*cosiapi.Bucket)
+	if !ok {
+		return nil
+	}
+
+	claimRef := bucket.Spec.BucketClaimRef
+	if claimRef.Namespace == "" || claimRef.Name == "" {
+		return nil
+	}
+
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: claimRef.Namespace, Name: claimRef.Name}},
+	}
+}
+
 func (r *BucketClaimReconciler) reconcile(ctx context.Context, logger logr.Logger, claim *cosiapi.BucketClaim) error {
+	if !claim.GetDeletionTimestamp().IsZero() {
+		logger.V(1).Info("beginning BucketClaim deletion cleanup")
+		return r.reconcileDelete(ctx, logger, claim)
+	}
+
 	bucketName, err := determineBucketName(claim)
 	if err != nil {
 		// Opinion: It is best to not apply a missing finalizer when boundBucketName is degraded
@@ -136,20 +288,6 @@ func (r *BucketClaimReconciler) reconcile(ctx context.Context, logger logr.Logge
 
 	isStaticProvisioning := claim.Spec.ExistingBucketName != ""
 
-	if !claim.GetDeletionTimestamp().IsZero() {
-		logger.V(1).Info("beginning BucketClaim deletion cleanup")
-
-		// TODO: deletion logic
-
-		ctrlutil.RemoveFinalizer(claim, cosiapi.ProtectionFinalizer)
-		if err := r.Update(ctx, claim); err != nil {
-			logger.Error(err, "failed to remove finalizer")
-			return fmt.Errorf("failed to remove finalizer: %w", err)
-		}
-
-		return cosierr.NonRetryableError(fmt.Errorf("deletion is not yet implemented")) // TODO
-	}
-
 	logger.V(1).Info("reconciling BucketClaim")
 
 	didAdd := ctrlutil.AddFinalizer(claim, cosiapi.ProtectionFinalizer)
@@ -188,10 +326,13 @@ func (r *BucketClaimReconciler) reconcile(ctx context.Context, logger logr.Logge
 
 		// Claim is not bound yet, this is normal dynamic provisioning.
 		logger.Info("creating intermediate Bucket")
-		bucket, err = createIntermediateBucket(ctx, logger, r.Client, claim, bucketName)
+		reader := apiReaderOrClient(r.APIReader, r.Client)
+		bucket, err = createIntermediateBucket(ctx, logger, r.Client, reader, claim, bucketName)
 		if err != nil {
 			return err
 		}
+		cosievents.Normal(r.Recorder, claim, bucket, cosievents.ReasonBucketCreated, cosievents.ActionCreate,
+			"created Bucket %q from BucketClass %q", bucket.Name, claim.Spec.BucketClassName)
 	}
 
 	isBound, err := bucketIsBoundToClaim(bucket, claim)
@@ -217,17 +358,31 @@ func (r *BucketClaimReconciler) reconcile(ctx context.Context, logger logr.Logge
 			claim.Status.ReadyToUse = ptr.To(false)
 		}
 		claim.Status.BoundBucketName = bucketName
+		cosiconditions.SetSucceeded(claim, &claim.Status.Conditions, cosiapi.ConditionBound)
+		setProvisionedFromBucket(claim, bucket)
+		cosiconditions.SetReady(claim, &claim.Status.Conditions, claim.Status.ReadyToUse)
 		if err := r.Status().Update(ctx, claim); err != nil {
 			logger.Error(err, "failed to bind BucketClaim to Bucket")
 			return fmt.Errorf("failed to bind BucketClaim to Bucket: %w", err)
 		}
+		cosievents.Normal(r.Recorder, claim, bucket, cosievents.ReasonBound, cosievents.ActionBind,
+			"bound to Bucket %q", bucketName)
 	}
 
 	if bucket.Status.BucketID == "" {
-		// TODO: In the future, set up Bucket watcher to enqueue this BucketClaim when the Bucket
-		// is updated. For now, return error to requeue with backoff.
+		// The Bucket watch enqueues this BucketClaim when the Sidecar updates the Bucket status.
 		logger.Info("waiting for Bucket to be provisioned")
-		return fmt.Errorf("waiting for Bucket to be provisioned")
+		// Mirror the Bucket's provisioning progress so that users can see it on the BucketClaim.
+		oldConditions := slices.Clone(claim.Status.Conditions)
+		setProvisionedFromBucket(claim, bucket)
+		cosiconditions.SetReady(claim, &claim.Status.Conditions, claim.Status.ReadyToUse)
+		if !equality.Semantic.DeepEqual(oldConditions, claim.Status.Conditions) {
+			if err := r.Status().Update(ctx, claim); err != nil {
+				logger.Error(err, "failed to update BucketClaim conditions while waiting for provisioning")
+				return err
+			}
+		}
+		return nil
 	}
 
 	readyToUse := ptr.Deref(bucket.Status.ReadyToUse, false)
@@ -236,17 +391,153 @@ func (r *BucketClaimReconciler) reconcile(ctx context.Context, logger logr.Logge
 		return cosierr.NonRetryableError(fmt.Errorf("provisioned Bucket supports no protocols"))
 	}
 
+	wasReady := ptr.Deref(claim.Status.ReadyToUse, false)
 	claim.Status.ReadyToUse = bucket.Status.ReadyToUse
 	claim.Status.Protocols = bucket.Status.Protocols
 	claim.Status.Error = nil
+	cosiconditions.SetSucceeded(claim, &claim.Status.Conditions, cosiapi.ConditionBound)
+	setProvisionedFromBucket(claim, bucket)
+	cosiconditions.SetReady(claim, &claim.Status.Conditions, claim.Status.ReadyToUse)
 	if err := r.Status().Update(ctx, claim); err != nil {
 		logger.Error(err, "failed to update BucketClaim status after successful provisioning")
 		return err
 	}
+	if !wasReady && readyToUse {
+		cosimetrics.ObserveReady(cosimetrics.KindBucketClaim, bucket.Spec.DriverName, claim)
+		cosievents.Normal(r.Recorder, claim, bucket, cosievents.ReasonProvisioned, cosievents.ActionProvision,
+			"Bucket %q is provisioned and ready to use", bucket.Name)
+	}
+
+	return nil
+}
+
+func (r *BucketClaimReconciler) reconcileDelete(
+	ctx context.Context, logger logr.Logger, claim *cosiapi.BucketClaim,
+) error {
+	bucket, err := r.getBoundBucketForDeletion(ctx, logger, claim)
+	if err != nil {
+		return err
+	}
+
+	if bucket != nil {
+		logger = logger.WithValues("bucketName", bucket.Name)
+
+		if bucket.Annotations == nil {
+			bucket.Annotations = map[string]string{}
+		}
+		if _, ok := bucket.Annotations[cosiapi.BucketClaimBeingDeletedAnnotation]; !ok {
+			// Signal to Sidecars that no new accesses should be provisioned for the Bucket.
+			bucket.Annotations[cosiapi.BucketClaimBeingDeletedAnnotation] = ""
+			if err := r.Update(ctx, bucket); err != nil {
+				logger.Error(err, "failed to mark Bucket as having a deleting BucketClaim")
+				return fmt.Errorf("failed to mark Bucket as having a deleting BucketClaim: %w", err)
+			}
+		}
+	}
+
+	// The annotation may appear at any point before the finalizer is removed (see
+	// markAllBucketClaimsAsAccessed()). Check it after marking the Bucket but before the point of
+	// no return. If it appears after this check, the finalizer removal below fails with a conflict.
+	if _, ok := claim.Annotations[cosiapi.HasBucketAccessReferencesAnnotation]; ok {
+		logger.Info("waiting for BucketAccesses referencing BucketClaim to be deleted")
+		return cosiconditions.WithReason(cosiapi.ReasonBucketAccessesExist,
+			fmt.Errorf("waiting for BucketAccesses referencing BucketClaim to be deleted"))
+	}
+
+	if bucket != nil {
+		if err := r.deleteOrReleaseBucket(ctx, logger, bucket, claim); err != nil {
+			return err
+		}
+	}
+
+	ctrlutil.RemoveFinalizer(claim, cosiapi.ProtectionFinalizer)
+	if err := r.Update(ctx, claim); err != nil {
+		logger.Error(err, "failed to remove finalizer")
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
 
 	return nil
 }
 
+// Get the Bucket bound to the deleting BucketClaim.
+// Returns nil without error if there is no Bucket that COSI should clean up.
+func (r *BucketClaimReconciler) getBoundBucketForDeletion(
+	ctx context.Context, logger logr.Logger, claim *cosiapi.BucketClaim,
+) (*cosiapi.Bucket, error) {
+	bucketName, err := determineBucketName(claim)
+	if err != nil {
+		// A degraded BucketClaim can't be certain which Bucket it is bound to. Do not risk deleting
+		// or releasing a Bucket that might belong to something else. Any Bucket left behind
+		// references a BucketClaim that no longer exists and can be found as an orphan.
+		logger.Error(err, "not cleaning up Bucket for degraded BucketClaim")
+		return nil, nil
+	}
+
+	bucket := &cosiapi.Bucket{}
+	bucketNsName := types.NamespacedName{
+		Name:      bucketName,
+		Namespace: "", // global resource
+	}
+	if err := r.Get(ctx, bucketNsName, bucket); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.V(1).Info("no Bucket to clean up", "bucketName", bucketName)
+			return nil, nil
+		}
+		logger.Error(err, "failed to determine if Bucket exists", "bucketName", bucketName)
+		return nil, err
+	}
+
+	isBound, err := bucketIsBoundToClaim(bucket, claim)
+	if err != nil || !isBound {
+		// e.g., a static Bucket that was never bound, or that is bound to a different BucketClaim
+		logger.Info("not cleaning up Bucket that is not bound to BucketClaim", "bucketName", bucketName, "reason", err)
+		return nil, nil
+	}
+
+	return bucket, nil
+}
+
+// Delete or release the Bucket bound to a deleting BucketClaim. Dynamically-provisioned Buckets are
+// deleted or released according to their deletion policy. Statically-provisioned Buckets are
+// always released. Released Buckets are marked with the BucketReleasedAnnotation. Returns nil once
+// the BucketClaim can safely finish deletion.
+func (r *BucketClaimReconciler) deleteOrReleaseBucket(
+	ctx context.Context, logger logr.Logger, bucket *cosiapi.Bucket, claim *cosiapi.BucketClaim,
+) error {
+	isStaticProvisioning := claim.Spec.ExistingBucketName != ""
+	if isStaticProvisioning {
+		// Statically-provisioned Buckets are created by administrators. Leave it to them to decide
+		// what to do with a released Bucket.
+		logger.Info("releasing statically-provisioned Bucket")
+		return markBucketReleased(ctx, logger, r.Client, bucket)
+	}
+
+	if bucket.Spec.DeletionPolicy != cosiapi.BucketDeletionPolicyDelete {
+		logger.Info("releasing Bucket", "deletionPolicy", bucket.Spec.DeletionPolicy)
+		return markBucketReleased(ctx, logger, r.Client, bucket)
+	}
+
+	if bucket.GetDeletionTimestamp().IsZero() {
+		logger.Info("deleting intermediate Bucket")
+		if err := r.Delete(ctx, bucket); err != nil && !kerrors.IsNotFound(err) {
+			logger.Error(err, "failed to delete intermediate Bucket")
+			return fmt.Errorf("failed to delete intermediate Bucket: %w", err)
+		}
+	}
+
+	// The Sidecar removes the Bucket's finalizer once the backend bucket is deleted. Wait for that
+	// so that backend deletion problems remain visible to the user on the BucketClaim.
+	if err := r.Get(ctx, client.ObjectKeyFromObject(bucket), bucket); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		logger.Error(err, "failed to determine if intermediate Bucket is deleted")
+		return err
+	}
+	logger.Info("waiting for intermediate Bucket to be deleted")
+	return fmt.Errorf("waiting for intermediate Bucket %q to be deleted", bucket.Name)
+}
+
 // Determine the bucket name that should go with the claim. No errors can be retried.
 func determineBucketName(claim *cosiapi.BucketClaim) (string, error) {
 	name := ""
@@ -281,6 +572,7 @@ func createIntermediateBucket(
 	ctx context.Context,
 	logger logr.Logger,
 	client client.Client,
+	reader client.Reader,
 	claim *cosiapi.BucketClaim,
 	bucketName string,
 ) (*cosiapi.Bucket, error) {
@@ -299,10 +591,8 @@ func createIntermediateBucket(
 	}
 	if err := client.Get(ctx, classNsName, class); err != nil {
 		if kerrors.IsNotFound(err) {
-			// TODO: for now, return an error and allow the controller to exponential backoff
-			// until the BucketClass exists. in the future, optimize this by adding a
-			// BucketClass reconciler that enqueues requests for BucketClaims that reference the
-			// class and don't yet have a bound Bucket.
+			// The BucketClass watch enqueues this BucketClaim as soon as the class is created.
+			// Until then, backoff retries are a fallback, and the error is visible to the user.
 			logger.Error(err, "BucketClass not found")
 			return nil, err
 		}
@@ -310,11 +600,36 @@ func createIntermediateBucket(
 		return nil, err
 	}
 
+	if err := checkNamespaceAllowed(ctx, client, "BucketClass", className,
+		class.Spec.AllowedNamespaces, claim.Namespace); err != nil {
+		logger.Error(err, "BucketClass may not be used from BucketClaim namespace")
+		return nil, err
+	}
+
+	// The cache may not yet have a Bucket created by a previous reconcile. Its BucketClaim already
+	// has quota usage reserved, so reserving again would count it twice.
+	if err := reader.Get(ctx, types.NamespacedName{Name: bucketName}, &cosiapi.Bucket{}); err == nil {
+		logger.Error(nil, "intermediate Bucket already exists")
+		return nil, fmt.Errorf("intermediate Bucket %q already exists", bucketName)
+	} else if !kerrors.IsNotFound(err) {
+		logger.Error(err, "failed to determine if intermediate Bucket exists")
+		return nil, err
+	}
+
+	reservation, err := reserveBucketClaimQuota(ctx, client, reader, claim)
+	if err != nil {
+		logger.Error(err, "failed to reserve BucketQuota usage for BucketClaim")
+		return nil, err
+	}
+
 	logger.V(1).Info("using BucketClass for intermediate Bucket")
 
 	bucket := generateIntermediateBucket(claim, class, bucketName)
+	// Let the Sidecar continue this trace when it provisions the Bucket.
+	cositracing.InjectAnnotation(ctx, bucket)
 
 	if err := client.Create(ctx, bucket); err != nil {
+		reservation.release(ctx, client, reader)
 		if kerrors.IsAlreadyExists(err) {
 			// Unlikely race condition. Error to allow the next reconcile to attempt to recover.
 			logger.Error(err, "intermediate Bucket already exists")
@@ -327,6 +642,21 @@ func createIntermediateBucket(
 	return bucket, nil
 }
 
+// Mirror the Bucket's Provisioned condition on the BucketClaim. A Bucket that has a bucket ID but no
+// Provisioned condition was provisioned before conditions were reported.
+func setProvisionedFromBucket(claim *cosiapi.BucketClaim, bucket *cosiapi.Bucket) {
+	if c := apimeta.FindStatusCondition(bucket.Status.Conditions, cosiapi.ConditionProvisioned); c != nil {
+		cosiconditions.Set(claim, &claim.Status.Conditions, cosiapi.ConditionProvisioned, c.Status, c.Reason, c.Message)
+		return
+	}
+	if bucket.Status.BucketID != "" {
+		cosiconditions.SetSucceeded(claim, &claim.Status.Conditions, cosiapi.ConditionProvisioned)
+		return
+	}
+	cosiconditions.SetPending(claim, &claim.Status.Conditions, cosiapi.ConditionProvisioned,
+		fmt.Sprintf("waiting for Bucket %q to be provisioned", bucket.Name))
+}
+
 func generateIntermediateBucket(
 	claim *cosiapi.BucketClaim, class *cosiapi.BucketClass, bucketName string,
 ) *cosiapi.Bucket {
@@ -17,15 +17,29 @@ limitations under the License.
 package reconciler
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
 	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
 	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 )
 
 func Test_determineBucketName(t *testing.T) {
@@ -104,6 +118,72 @@ func Test_determineBucketName(t *testing.T) {
 	})
 }
 
+func Test_bucketClaimForBucket(t *testing.T) {
+	t.Run("bound Bucket", func(t *testing.T) {
+		bucket := &cosiapi.Bucket{
+			ObjectMeta: meta.ObjectMeta{Name: "bc-qwerty"},
+			Spec: cosiapi.BucketSpec{
+				BucketClaimRef: cosiapi.BucketClaimReference{
+					Name:      "test-bucket",
+					Namespace: "user-ns",
+					UID:       types.UID("qwerty"),
+				},
+			},
+		}
+
+		reqs := bucketClaimForBucket(context.Background(), bucket)
+		assert.Equal(t,
+			[]reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "user-ns", Name: "test-bucket"}}},
+			reqs,
+		)
+	})
+
+	t.Run("no claim ref", func(t *testing.T) {
+		bucket := &cosiapi.Bucket{
+			ObjectMeta: meta.ObjectMeta{Name: "bc-qwerty"},
+		}
+
+		assert.Empty(t, bucketClaimForBucket(context.Background(), bucket))
+	})
+
+	t.Run("not a Bucket", func(t *testing.T) {
+		claim := &cosiapi.BucketClaim{
+			ObjectMeta: meta.ObjectMeta{Name: "test-bucket", Namespace: "user-ns"},
+		}
+
+		assert.Empty(t, bucketClaimForBucket(context.Background(), claim))
+	})
+}
+
+func Test_unboundBucketClaimsForBucketClass(t *testing.T) {
+	class := cositest.OpinionatedS3BucketClass()
+
+	unbound := cositest.OpinionatedS3BucketClaim("ns1", "unbound")
+	unboundOtherNs := cositest.OpinionatedS3BucketClaim("ns2", "unbound")
+	bound := cositest.OpinionatedS3BucketClaim("ns1", "bound")
+	bound.Status.BoundBucketName = "bc-" + string(bound.UID)
+	otherClass := cositest.OpinionatedGcsBucketClaim("ns1", "other-class")
+	static := cositest.OpinionatedS3BucketClaim("ns1", "static")
+	static.Spec.ExistingBucketName = "admin-created-bucket"
+
+	bootstrapped := cositest.MustBootstrap(t,
+		class, unbound, unboundOtherNs, bound, otherClass, static,
+	)
+	r := BucketClaimReconciler{
+		Client: bootstrapped.Client,
+		Scheme: bootstrapped.Client.Scheme(),
+	}
+
+	reqs := r.unboundBucketClaimsForBucketClass(bootstrapped.ContextWithLogger, class)
+	assert.ElementsMatch(t,
+		[]reconcile.Request{
+			{NamespacedName: cositest.NsName(unbound)},
+			{NamespacedName: cositest.NsName(unboundOtherNs)},
+		},
+		reqs,
+	)
+}
+
 func Test_createIntermediateBucket(t *testing.T) {
 	// valid base claim used for subtests
 	baseClaim := cosiapi.BucketClaim{
@@ -142,7 +222,7 @@ func Test_createIntermediateBucket(t *testing.T) {
 		)
 
 		bucket, err := createIntermediateBucket(
-			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client,
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
 			claim, "bc-qwerty",
 		)
 		assert.NoError(t, err)
@@ -161,6 +241,31 @@ func Test_createIntermediateBucket(t *testing.T) {
 		assert.Equal(t, "my-bucket", claimRef.Name)
 		assert.Equal(t, "my-ns", claimRef.Namespace)
 		assert.Equal(t, "qwerty", string(claimRef.UID))
+
+		assert.NotContains(t, bucket.Annotations, cosiapi.TraceContextAnnotation) // tracing disabled
+	})
+
+	t.Run("trace context is passed to the Sidecar", func(t *testing.T) {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+		defer func() {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+			otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+		}()
+
+		claim := baseClaim.DeepCopy()
+		bootstrapped := cositest.MustBootstrap(t,
+			baseClass.DeepCopy(),
+		)
+
+		ctx, span := cositracing.StartReconcile(bootstrapped.ContextWithLogger, "BucketClaim", claim, nil)
+		defer span.End()
+		bucket, err := createIntermediateBucket(ctx, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client, claim, "bc-qwerty")
+		assert.NoError(t, err)
+
+		sc := span.SpanContext()
+		assert.Equal(t, fmt.Sprintf("00-%s-%s-01", sc.TraceID(), sc.SpanID()),
+			bucket.Annotations[cosiapi.TraceContextAnnotation])
 	})
 
 	t.Run("bucketClass does not exist", func(t *testing.T) {
@@ -168,7 +273,7 @@ func Test_createIntermediateBucket(t *testing.T) {
 		bootstrapped := cositest.MustBootstrap(t) // no bucketclass exists
 
 		bucket, err := createIntermediateBucket(
-			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client,
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
 			claim, "bc-qwerty",
 		)
 		assert.Error(t, err)
@@ -177,6 +282,162 @@ func Test_createIntermediateBucket(t *testing.T) {
 		assert.Nil(t, bucket)
 	})
 
+	t.Run("class allows claim namespace", func(t *testing.T) {
+		claim := baseClaim.DeepCopy()
+		class := baseClass.DeepCopy()
+		class.Spec.AllowedNamespaces = &meta.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}
+		ns := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{
+			Name:   "my-ns",
+			Labels: map[string]string{"tier": "premium"},
+		}}
+		bootstrapped := cositest.MustBootstrap(t, class, ns)
+
+		bucket, err := createIntermediateBucket(
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
+			claim, "bc-qwerty",
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, "bc-qwerty", bucket.Name)
+	})
+
+	t.Run("class does not allow claim namespace", func(t *testing.T) {
+		claim := baseClaim.DeepCopy()
+		class := baseClass.DeepCopy()
+		class.Spec.AllowedNamespaces = &meta.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}
+		ns := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{
+			Name:   "my-ns",
+			Labels: map[string]string{"tier": "standard"},
+		}}
+		bootstrapped := cositest.MustBootstrap(t, class, ns)
+
+		bucket, err := createIntermediateBucket(
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
+			claim, "bc-qwerty",
+		)
+		assert.EqualError(t, err, `BucketClass "s3-class" may not be used from Namespace "my-ns"`)
+		assert.ErrorIs(t, err, cosierr.NonRetryableError(nil))
+		assert.Equal(t, cosiapi.ReasonNamespaceNotAllowed, cosiconditions.Reason(err))
+		assert.Nil(t, bucket)
+
+		err = bootstrapped.Client.Get(bootstrapped.ContextWithLogger, types.NamespacedName{Name: "bc-qwerty"},
+			&cosiapi.Bucket{})
+		assert.True(t, kerrors.IsNotFound(err))
+	})
+
+	t.Run("bucket quota", func(t *testing.T) {
+		boundClaim := baseClaim.DeepCopy()
+		boundClaim.Name = "bound"
+		boundClaim.UID = "bound-uid"
+		boundClaim.Status.BoundBucketName = "bc-bound-uid"
+		quota := func(name, className string, hard int32, used *int32) *cosiapi.BucketQuota {
+			return &cosiapi.BucketQuota{
+				ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: name},
+				Spec: cosiapi.BucketQuotaSpec{
+					Hard:            cosiapi.BucketQuotaCounts{BucketClaims: &hard},
+					BucketClassName: className,
+				},
+				Status: cosiapi.BucketQuotaStatus{
+					Used: cosiapi.BucketQuotaCounts{BucketClaims: used},
+				},
+			}
+		}
+
+		tests := []struct {
+			name         string
+			quota        *cosiapi.BucketQuota
+			wantUsed     *int32
+			wantReserved bool
+			wantErr      string
+		}{
+			{"under quota", quota("all", "", 2, ptr.To[int32](1)), ptr.To[int32](2), true, ""},
+			{"quota for another class", quota("gold", "gold-class", 1, ptr.To[int32](0)), ptr.To[int32](0), false, ""},
+			{"quota exceeded", quota("all", "", 1, ptr.To[int32](1)), ptr.To[int32](1), false,
+				`exceeded BucketQuota "all": 1 of 1 BucketClaims are in use`},
+			{"class quota exceeded", quota("s3", "s3-class", 1, ptr.To[int32](1)), ptr.To[int32](1), false,
+				`exceeded BucketQuota "s3": 1 of 1 BucketClaims are in use`},
+			{"usage not calculated", quota("all", "", 2, nil), nil, false,
+				`waiting for BucketQuota "all" usage to be calculated`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				bootstrapped := cositest.MustBootstrap(t,
+					baseClass.DeepCopy(), baseClaim.DeepCopy(), boundClaim.DeepCopy(), tt.quota)
+				ctx := bootstrapped.ContextWithLogger
+
+				claim := &cosiapi.BucketClaim{}
+				require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(&baseClaim), claim))
+				bucket, err := createIntermediateBucket(
+					ctx, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client, claim, "bc-qwerty",
+				)
+				quota := &cosiapi.BucketQuota{}
+				require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(tt.quota), quota))
+				assert.Equal(t, tt.wantUsed, quota.Status.Used.BucketClaims)
+				require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(&baseClaim), claim))
+				if tt.wantReserved {
+					assert.Contains(t, claim.Annotations, cosiapi.QuotaReservedAnnotation)
+				} else {
+					assert.NotContains(t, claim.Annotations, cosiapi.QuotaReservedAnnotation)
+				}
+				if tt.wantErr == "" {
+					assert.NoError(t, err)
+					assert.NotNil(t, bucket)
+					return
+				}
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, bucket)
+				if cosiconditions.Reason(err) == cosiapi.ReasonQuotaExceeded {
+					assert.ErrorIs(t, err, cosierr.NonRetryableError(nil))
+				}
+			})
+		}
+
+		t.Run("claims admitted back-to-back", func(t *testing.T) {
+			second := baseClaim.DeepCopy()
+			second.Name = "second"
+			second.UID = "second-uid"
+			bootstrapped := cositest.MustBootstrap(t,
+				baseClass.DeepCopy(), baseClaim.DeepCopy(), second.DeepCopy(), quota("all", "", 1, ptr.To[int32](0)))
+			ctx := bootstrapped.ContextWithLogger
+
+			// the first claim is admitted, but is not yet bound to its Bucket
+			first := &cosiapi.BucketClaim{}
+			require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(&baseClaim), first))
+			bucket, err := createIntermediateBucket(
+				ctx, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client, first, "bc-qwerty",
+			)
+			require.NoError(t, err)
+			require.NotNil(t, bucket)
+
+			require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(second), second))
+			bucket, err = createIntermediateBucket(
+				ctx, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client, second, "bc-second-uid",
+			)
+			assert.EqualError(t, err, `exceeded BucketQuota "all": 1 of 1 BucketClaims are in use`)
+			assert.Nil(t, bucket)
+
+			err = bootstrapped.Client.Get(ctx, types.NamespacedName{Name: "bc-second-uid"}, &cosiapi.Bucket{})
+			assert.True(t, kerrors.IsNotFound(err))
+		})
+
+		t.Run("usage released when Bucket creation fails", func(t *testing.T) {
+			q := quota("all", "", 1, ptr.To[int32](0))
+			bootstrapped := cositest.MustBootstrap(t, baseClass.DeepCopy(), baseClaim.DeepCopy(), q)
+			ctx := bootstrapped.ContextWithLogger
+
+			claim := &cosiapi.BucketClaim{}
+			require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(&baseClaim), claim))
+			c := failingCreateClient{Client: bootstrapped.Client}
+			bucket, err := createIntermediateBucket(ctx, bootstrapped.Logger, c, c, claim, "bc-qwerty")
+			assert.ErrorContains(t, err, "fake create error")
+			assert.Nil(t, bucket)
+
+			require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(q), q))
+			assert.Equal(t, ptr.To[int32](0), q.Status.Used.BucketClaims)
+			require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(&baseClaim), claim))
+			assert.NotContains(t, claim.Annotations, cosiapi.QuotaReservedAnnotation)
+		})
+	})
+
 	t.Run("claim specifies no class", func(t *testing.T) {
 		claim := baseClaim.DeepCopy()
 		claim.Spec.BucketClassName = ""
@@ -185,7 +446,7 @@ func Test_createIntermediateBucket(t *testing.T) {
 		)
 
 		bucket, err := createIntermediateBucket(
-			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client,
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
 			claim, "bc-qwerty",
 		)
 		assert.Error(t, err)
@@ -207,7 +468,7 @@ func Test_createIntermediateBucket(t *testing.T) {
 		)
 
 		bucket, err := createIntermediateBucket(
-			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client,
+			bootstrapped.ContextWithLogger, bootstrapped.Logger, bootstrapped.Client, bootstrapped.Client,
 			claim, "bc-qwerty",
 		)
 		assert.Error(t, err)
@@ -215,3 +476,12 @@ func Test_createIntermediateBucket(t *testing.T) {
 		assert.Nil(t, bucket)
 	})
 }
+
+// A client whose creates always fail.
+type failingCreateClient struct {
+	client.Client
+}
+
+func (c failingCreateClient) Create(context.Context, client.Object, ...client.CreateOption) error {
+	return fmt.Errorf("fake create error")
+}
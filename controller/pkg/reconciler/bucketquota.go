@@ -0,0 +1,403 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
+	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
+)
+
+// BucketQuotaReconciler reconciles a BucketQuota object.
+// The BucketClaim and BucketAccess reconcilers reserve usage in the BucketQuota status before
+// provisioning resources. This reconciler recalculates usage when a BucketQuota is created or
+// changed, and when resources are deleted, which releases their usage.
+type BucketQuotaReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// APIReader reads directly from the API server so that usage includes resources that are not
+	// yet in the Client's cache. If nil, Client is used.
+	APIReader client.Reader
+}
+
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketquotas/status,verbs=get;update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *BucketQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	reader := apiReaderOrClient(r.APIReader, r.Client)
+
+	// Read the latest BucketQuota so that the status update conflicts with any reservation made
+	// after usage is counted.
+	quota := &cosiapi.BucketQuota{}
+	if err := reader.Get(ctx, req.NamespacedName, quota); err != nil {
+		if kerrors.IsNotFound(err) {
+			logger.V(1).Info("not reconciling nonexistent BucketQuota")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "failed to get BucketQuota")
+		return ctrl.Result{}, err
+	}
+
+	claims := &cosiapi.BucketClaimList{}
+	if err := reader.List(ctx, claims, client.InNamespace(quota.Namespace)); err != nil {
+		logger.Error(err, "failed to list BucketClaims")
+		return ctrl.Result{}, err
+	}
+	usedClaims, err := usedBucketClaims(ctx, reader, quota, claims.Items)
+	if err != nil {
+		logger.Error(err, "failed to count BucketClaims")
+		return ctrl.Result{}, err
+	}
+
+	accesses := &cosiapi.BucketAccessList{}
+	if err := reader.List(ctx, accesses, client.InNamespace(quota.Namespace)); err != nil {
+		logger.Error(err, "failed to list BucketAccesses")
+		return ctrl.Result{}, err
+	}
+
+	used := cosiapi.BucketQuotaCounts{
+		BucketClaims:   ptr.To(usedClaims),
+		BucketAccesses: ptr.To(usedBucketAccesses(quota, accesses.Items)),
+	}
+	if equality.Semantic.DeepEqual(used, quota.Status.Used) {
+		return ctrl.Result{}, nil
+	}
+
+	logger.V(1).Info("updating BucketQuota usage",
+		"bucketClaims", *used.BucketClaims, "bucketAccesses", *used.BucketAccesses)
+	quota.Status.Used = used
+	if err := r.Status().Update(ctx, quota); err != nil {
+		// A conflict means usage was reserved meanwhile. Count again.
+		logger.Error(err, "failed to update BucketQuota usage")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BucketQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&cosiapi.BucketQuota{},
+			builder.WithPredicates(
+				ctrlpredicate.Or(
+					cosipredicate.AnyCreate(),
+					cosipredicate.AnyGeneric(),
+				),
+			),
+		).
+		Watches(
+			&cosiapi.BucketClaim{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketQuotasInNamespace),
+			builder.WithPredicates(
+				cosipredicate.AnyDelete(), // release usage of deleted BucketClaims
+			),
+		).
+		Watches(
+			&cosiapi.BucketAccess{},
+			handler.EnqueueRequestsFromMapFunc(r.bucketQuotasInNamespace),
+			builder.WithPredicates(
+				cosipredicate.AnyDelete(), // release usage of deleted BucketAccesses
+			),
+		).
+		Named("bucketquota").
+		Complete(r)
+}
+
+// Map a namespaced resource to all BucketQuotas in the same namespace.
+func (r *BucketQuotaReconciler) bucketQuotasInNamespace(
+	ctx context.Context, obj client.Object,
+) []reconcile.Request {
+	logger := ctrl.LoggerFrom(ctx).WithValues("namespace", obj.GetNamespace())
+
+	quotas := &cosiapi.BucketQuotaList{}
+	if err := r.List(ctx, quotas, client.InNamespace(obj.GetNamespace())); err != nil {
+		logger.Error(err, "failed to list BucketQuotas in namespace")
+		return nil
+	}
+
+	reqs := make([]reconcile.Request, 0, len(quotas.Items))
+	for _, quota := range quotas.Items {
+		reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&quota)})
+	}
+	return reqs
+}
+
+// Return the reader for uncached reads, or the client if there is none.
+func apiReaderOrClient(apiReader client.Reader, c client.Client) client.Reader {
+	if apiReader != nil {
+		return apiReader
+	}
+	return c
+}
+
+// A quotaReservation holds usage reserved in BucketQuotas for one resource before it is
+// provisioned. If provisioning fails, the reservation must be released.
+type quotaReservation struct {
+	resources string                                   // resource type, for messages
+	count     func(*cosiapi.BucketQuotaCounts) **int32 // selects the resource type's count
+	object    client.Object                            // resource with the QuotaReservedAnnotation
+	quotas    []types.NamespacedName                   // BucketQuotas where usage is reserved
+}
+
+// Reserve usage for a BucketClaim in every BucketQuota in its namespace that applies to it, before
+// a Bucket is provisioned for it.
+func reserveBucketClaimQuota(
+	ctx context.Context, c client.Client, reader client.Reader, claim *cosiapi.BucketClaim,
+) (*quotaReservation, error) {
+	res := &quotaReservation{
+		resources: "BucketClaims",
+		count:     func(q *cosiapi.BucketQuotaCounts) **int32 { return &q.BucketClaims },
+	}
+	inScope := func(q *cosiapi.BucketQuota) bool { return bucketClaimInQuotaScope(q, claim) }
+	return res, res.reserve(ctx, c, reader, claim, inScope)
+}
+
+// Reserve usage for a BucketAccess in every BucketQuota in its namespace that applies to it, before
+// the BucketAccess is handed off to the Sidecar.
+func reserveBucketAccessQuota(
+	ctx context.Context, c client.Client, reader client.Reader, access *cosiapi.BucketAccess,
+) (*quotaReservation, error) {
+	res := &quotaReservation{
+		resources: "BucketAccesses",
+		count:     func(q *cosiapi.BucketQuotaCounts) **int32 { return &q.BucketAccesses },
+	}
+	inScope := func(q *cosiapi.BucketQuota) bool { return bucketAccessInQuotaScope(q, access) }
+	return res, res.reserve(ctx, c, reader, access, inScope)
+}
+
+// Reserve usage for one more resource in each BucketQuota that limits it. BucketQuotas are read
+// from the API server, and each reservation is an optimistically-concurrent status update, so
+// concurrent reservations can't exceed a quota. If any reservation fails, prior ones are released.
+//
+// The resource is marked with the QuotaReservedAnnotation before usage is reserved, and the mark is
+// removed only after usage is released. Usage recalculated in between may count the resource twice
+// until the next recalculation, but it never misses a reservation.
+func (res *quotaReservation) reserve(
+	ctx context.Context,
+	c client.Client,
+	reader client.Reader,
+	obj client.Object,
+	inScope func(*cosiapi.BucketQuota) bool,
+) error {
+	quotas := &cosiapi.BucketQuotaList{}
+	if err := reader.List(ctx, quotas, client.InNamespace(obj.GetNamespace())); err != nil {
+		return fmt.Errorf("failed to list BucketQuotas: %w", err)
+	}
+
+	limiting := []*cosiapi.BucketQuota{}
+	for i := range quotas.Items {
+		quota := &quotas.Items[i]
+		hard := *res.count(&quota.Spec.Hard)
+		if hard == nil || !inScope(quota) {
+			continue
+		}
+
+		used := *res.count(&quota.Status.Used)
+		if used == nil {
+			// The BucketQuota status watch enqueues waiting resources once usage is calculated.
+			return fmt.Errorf("waiting for BucketQuota %q usage to be calculated", quota.Name)
+		}
+		if *used >= *hard {
+			return quotaExceededError(quota, res.resources, *used, *hard)
+		}
+		limiting = append(limiting, quota)
+	}
+	if len(limiting) == 0 {
+		return nil
+	}
+
+	if err := setQuotaReservedAnnotation(ctx, c, obj); err != nil {
+		return fmt.Errorf("failed to add BucketQuota reservation annotation: %w", err)
+	}
+	res.object = obj
+
+	for _, quota := range limiting {
+		hard := *res.count(&quota.Spec.Hard)
+		used := res.count(&quota.Status.Used)
+		if **used >= *hard {
+			res.release(ctx, c, reader)
+			return quotaExceededError(quota, res.resources, **used, *hard)
+		}
+
+		*used = ptr.To(**used + 1)
+		if err := c.Status().Update(ctx, quota); err != nil {
+			res.release(ctx, c, reader)
+			return fmt.Errorf("failed to reserve usage in BucketQuota %q: %w", quota.Name, err)
+		}
+		res.quotas = append(res.quotas, client.ObjectKeyFromObject(quota))
+	}
+	return nil
+}
+
+// Release reserved usage after provisioning fails. Release is best effort. If it fails, usage is
+// corrected when the BucketQuota reconciler next recalculates usage.
+func (res *quotaReservation) release(ctx context.Context, c client.Client, reader client.Reader) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	for _, nsName := range res.quotas {
+		for attempt := 1; ; attempt++ {
+			err := res.releaseOne(ctx, c, reader, nsName)
+			if err == nil {
+				break
+			}
+			if !kerrors.IsConflict(err) || attempt >= maxQuotaReleaseAttempts {
+				logger.Error(err, "failed to release BucketQuota usage", "bucketQuota", nsName.Name)
+				break
+			}
+		}
+	}
+	res.quotas = nil
+
+	// Unmark the resource only after usage is released so that recalculation can't miss usage.
+	if res.object != nil {
+		if err := removeQuotaReservedAnnotation(ctx, c, res.object); err != nil {
+			logger.Error(err, "failed to remove BucketQuota reservation annotation")
+		}
+		res.object = nil
+	}
+}
+
+// Release conflicts are retried this many times before usage is left for recalculation.
+const maxQuotaReleaseAttempts = 5
+
+func (res *quotaReservation) releaseOne(
+	ctx context.Context, c client.Client, reader client.Reader, nsName types.NamespacedName,
+) error {
+	quota := &cosiapi.BucketQuota{}
+	if err := reader.Get(ctx, nsName, quota); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	used := res.count(&quota.Status.Used)
+	if *used == nil || **used <= 0 {
+		return nil
+	}
+	*used = ptr.To(**used - 1)
+	return c.Status().Update(ctx, quota)
+}
+
+func quotaExceededError(quota *cosiapi.BucketQuota, resources string, used, hard int32) error {
+	return cosierr.NonRetryableError(cosiconditions.WithReason(cosiapi.ReasonQuotaExceeded,
+		fmt.Errorf("exceeded BucketQuota %q: %d of %d %s are in use", quota.Name, used, hard, resources)))
+}
+
+func setQuotaReservedAnnotation(ctx context.Context, c client.Client, obj client.Object) error {
+	if hasQuotaReservedAnnotation(obj) {
+		return nil
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[cosiapi.QuotaReservedAnnotation] = ""
+	obj.SetAnnotations(annotations)
+	return c.Update(ctx, obj)
+}
+
+func removeQuotaReservedAnnotation(ctx context.Context, c client.Client, obj client.Object) error {
+	if !hasQuotaReservedAnnotation(obj) {
+		return nil
+	}
+	annotations := obj.GetAnnotations()
+	delete(annotations, cosiapi.QuotaReservedAnnotation)
+	obj.SetAnnotations(annotations)
+	return client.IgnoreNotFound(c.Update(ctx, obj))
+}
+
+// Dynamically-provisioned BucketClaims that use the quota's BucketClass, if any, are in scope.
+func bucketClaimInQuotaScope(quota *cosiapi.BucketQuota, claim *cosiapi.BucketClaim) bool {
+	className := quota.Spec.BucketClassName
+	return claim.Spec.BucketClassName != "" && (className == "" || className == claim.Spec.BucketClassName)
+}
+
+// BucketAccesses that use the quota's BucketAccessClass, if any, are in scope.
+func bucketAccessInQuotaScope(quota *cosiapi.BucketQuota, access *cosiapi.BucketAccess) bool {
+	className := quota.Spec.BucketAccessClassName
+	return className == "" || className == access.Spec.BucketAccessClassName
+}
+
+// Count in-scope BucketClaims that have reserved usage or have an intermediate Bucket, whether or not
+// they are bound to it yet. Deleting BucketClaims still count until they are gone because their
+// Buckets may still exist.
+func usedBucketClaims(
+	ctx context.Context, reader client.Reader, quota *cosiapi.BucketQuota, claims []cosiapi.BucketClaim,
+) (int32, error) {
+	used := int32(0)
+	for i := range claims {
+		claim := &claims[i]
+		if !bucketClaimInQuotaScope(quota, claim) {
+			continue
+		}
+		if claim.Status.BoundBucketName != "" || hasQuotaReservedAnnotation(claim) {
+			used++
+			continue
+		}
+
+		bucketName, err := determineBucketName(claim)
+		if err != nil {
+			continue // degraded BucketClaims can't be provisioned
+		}
+		err = reader.Get(ctx, types.NamespacedName{Name: bucketName}, &cosiapi.Bucket{})
+		if err == nil {
+			used++ // Bucket was created, but the BucketClaim is not bound yet
+		} else if !kerrors.IsNotFound(err) {
+			return 0, fmt.Errorf("failed to get Bucket %q: %w", bucketName, err)
+		}
+	}
+	return used, nil
+}
+
+// Count in-scope BucketAccesses that have reserved usage or have been initialized and handed off to
+// the Sidecar.
+func usedBucketAccesses(quota *cosiapi.BucketQuota, accesses []cosiapi.BucketAccess) int32 {
+	used := int32(0)
+	for i := range accesses {
+		access := &accesses[i]
+		if !bucketAccessInQuotaScope(quota, access) {
+			continue
+		}
+		if len(access.Status.AccessedBuckets) > 0 || hasQuotaReservedAnnotation(access) {
+			used++
+		}
+	}
+	return used
+}
+
+func hasQuotaReservedAnnotation(obj client.Object) bool {
+	_, ok := obj.GetAnnotations()[cosiapi.QuotaReservedAnnotation]
+	return ok
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	controller "sigs.k8s.io/container-object-storage-interface/controller/pkg/reconciler"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+	controllertest "sigs.k8s.io/container-object-storage-interface/internal/test/controller"
+	sidecartest "sigs.k8s.io/container-object-storage-interface/internal/test/sidecar"
+)
+
+func TestBucketQuotaReconcile(t *testing.T) {
+	bound := func(claim *cosiapi.BucketClaim) *cosiapi.BucketClaim {
+		claim.Status.BoundBucketName = "bc-" + string(claim.UID)
+		return claim
+	}
+	initialized := func(access *cosiapi.BucketAccess) *cosiapi.BucketAccess {
+		access.Status.AccessedBuckets = []cosiapi.AccessedBucket{{BucketName: "bc-abc", BucketClaimName: "claim"}}
+		return access
+	}
+	access := func(namespace, name, className string) *cosiapi.BucketAccess {
+		return &cosiapi.BucketAccess{
+			ObjectMeta: meta.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       cosiapi.BucketAccessSpec{BucketAccessClassName: className},
+		}
+	}
+	// intermediate Bucket was created, but the BucketClaim is not bound to it yet
+	creatingClaim := cositest.OpinionatedS3BucketClaim("my-ns", "s3-creating")
+	creatingBucket := &cosiapi.Bucket{ObjectMeta: meta.ObjectMeta{Name: "bc-" + string(creatingClaim.UID)}}
+	// usage was reserved, but the intermediate Bucket is not created yet
+	reservingClaim := cositest.OpinionatedS3BucketClaim("my-ns", "s3-reserving")
+	reservingClaim.Annotations = map[string]string{cosiapi.QuotaReservedAnnotation: ""}
+	// usage was reserved, but the BucketAccess is not handed off yet
+	reservingAccess := access("my-ns", "s3-reserving", "s3-access")
+	reservingAccess.Annotations = map[string]string{cosiapi.QuotaReservedAnnotation: ""}
+	staticClaim := &cosiapi.BucketClaim{
+		ObjectMeta: cositest.ObjectMetaWithUID("my-ns", "static"),
+		Spec:       cosiapi.BucketClaimSpec{ExistingBucketName: "static-bucket"},
+		Status:     cosiapi.BucketClaimStatus{BoundBucketName: "static-bucket"},
+	}
+
+	objs := []client.Object{
+		bound(cositest.OpinionatedS3BucketClaim("my-ns", "s3-bound")),
+		bound(cositest.OpinionatedS3BucketClaim("my-ns", "s3-bound-2")),
+		cositest.OpinionatedS3BucketClaim("my-ns", "s3-unbound"),
+		creatingClaim,
+		creatingBucket,
+		reservingClaim,
+		bound(cositest.OpinionatedGcsBucketClaim("my-ns", "gcs-bound")),
+		bound(cositest.OpinionatedS3BucketClaim("other-ns", "s3-bound")),
+		staticClaim,
+		initialized(access("my-ns", "s3-initialized", "s3-access")),
+		access("my-ns", "s3-uninitialized", "s3-access"),
+		reservingAccess,
+		initialized(access("my-ns", "gcs-initialized", "gcs-access")),
+		initialized(access("other-ns", "s3-initialized", "s3-access")),
+	}
+
+	tests := []struct {
+		name     string
+		spec     cosiapi.BucketQuotaSpec
+		wantUsed cosiapi.BucketQuotaCounts
+	}{
+		{"all classes",
+			cosiapi.BucketQuotaSpec{Hard: cosiapi.BucketQuotaCounts{BucketClaims: ptr.To[int32](10)}},
+			cosiapi.BucketQuotaCounts{BucketClaims: ptr.To[int32](5), BucketAccesses: ptr.To[int32](3)}},
+		{"class-scoped",
+			cosiapi.BucketQuotaSpec{
+				Hard:                  cosiapi.BucketQuotaCounts{BucketAccesses: ptr.To[int32](10)},
+				BucketClassName:       "opinionated-s3",
+				BucketAccessClassName: "s3-access",
+			},
+			cosiapi.BucketQuotaCounts{BucketClaims: ptr.To[int32](4), BucketAccesses: ptr.To[int32](2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quota := &cosiapi.BucketQuota{
+				ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-quota"},
+				Spec:       tt.spec,
+			}
+			bootstrapped := cositest.MustBootstrap(t, append(objs, quota)...)
+			ctx := bootstrapped.ContextWithLogger
+
+			r := controller.BucketQuotaReconciler{
+				Client: bootstrapped.Client,
+				Scheme: bootstrapped.Client.Scheme(),
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(quota)})
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+
+			err = r.Get(ctx, cositest.NsName(quota), quota)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantUsed, quota.Status.Used)
+		})
+	}
+}
+
+func TestBucketQuotaReconcile_reservationInProgress(t *testing.T) {
+	quota := &cosiapi.BucketQuota{
+		ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-quota"},
+		Spec: cosiapi.BucketQuotaSpec{
+			Hard: cosiapi.BucketQuotaCounts{BucketClaims: ptr.To[int32](1), BucketAccesses: ptr.To[int32](1)},
+		},
+		Status: cosiapi.BucketQuotaStatus{
+			Used: cosiapi.BucketQuotaCounts{BucketClaims: ptr.To[int32](0), BucketAccesses: ptr.To[int32](0)},
+		},
+	}
+	accessClass := &cosiapi.BucketAccessClass{
+		ObjectMeta: meta.ObjectMeta{Name: "s3-class"},
+		Spec: cosiapi.BucketAccessClassSpec{
+			DriverName:         "cosi.s3.internal",
+			AuthenticationType: cosiapi.BucketAccessAuthenticationTypeKey,
+		},
+	}
+	claim := cositest.OpinionatedS3BucketClaim("my-ns", "my-claim")
+	access := &cosiapi.BucketAccess{
+		ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-access"},
+		Spec: cosiapi.BucketAccessSpec{
+			BucketClaims: []cosiapi.BucketClaimAccess{{
+				BucketClaimName:  claim.Name,
+				AccessMode:       cosiapi.BucketAccessModeReadWrite,
+				AccessSecretName: "my-creds",
+			}},
+			BucketAccessClassName: accessClass.Name,
+			Protocol:              cosiapi.ObjectProtocolS3,
+		},
+	}
+
+	bootstrapped := cositest.MustBootstrap(t,
+		quota.DeepCopy(),
+		cositest.OpinionatedS3BucketClass(),
+		claim.DeepCopy(),
+		accessClass,
+		access.DeepCopy(),
+	)
+	ctx := bootstrapped.ContextWithLogger
+
+	quotaReconciler := controller.BucketQuotaReconciler{
+		Client: bootstrapped.Client,
+		Scheme: bootstrapped.Client.Scheme(),
+	}
+	recounts := 0
+	recount := func() {
+		recounts++
+		_, err := quotaReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(quota)})
+		require.NoError(t, err)
+	}
+	assertUsed := func(claims, accesses int32) {
+		t.Helper()
+		got := &cosiapi.BucketQuota{}
+		require.NoError(t, bootstrapped.Client.Get(ctx, cositest.NsName(quota), got))
+		assert.Equal(t, ptr.To(claims), got.Status.Used.BucketClaims)
+		assert.Equal(t, ptr.To(accesses), got.Status.Used.BucketAccesses)
+	}
+	c := recountingClient{Client: bootstrapped.Client, recount: recount}
+
+	// usage is recalculated after it is reserved, but before the intermediate Bucket is created
+	claimReconciler := controller.BucketClaimReconciler{Client: c, Scheme: bootstrapped.Client.Scheme()}
+	_, err := claimReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(claim)})
+	require.NoError(t, err)
+	require.Equal(t, 1, recounts)
+	assertUsed(1, 0)
+
+	_, err = sidecartest.ReconcileOpinionatedS3Bucket(t, bootstrapped, cositest.BucketNsName(claim))
+	require.NoError(t, err)
+	bound, err := controllertest.ReconcileBucketClaim(t, bootstrapped, cositest.NsName(claim))
+	require.NoError(t, err)
+	require.True(t, *bound.Status.ReadyToUse)
+
+	recount()
+	assertUsed(1, 0)
+
+	// usage is recalculated after it is reserved, but before the BucketAccess is handed off
+	accessReconciler := controller.BucketAccessReconciler{Client: c, Scheme: bootstrapped.Client.Scheme()}
+	_, err = accessReconciler.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(access)})
+	require.NoError(t, err)
+	require.Equal(t, 3, recounts)
+	assertUsed(1, 1)
+
+	recount()
+	assertUsed(1, 1)
+}
+
+// A client that recalculates BucketQuota usage right before it creates a Bucket or hands off a
+// BucketAccess to the Sidecar, like a BucketQuota reconcile that runs concurrently with provisioning.
+type recountingClient struct {
+	client.Client
+	recount func()
+}
+
+func (c recountingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if _, ok := obj.(*cosiapi.Bucket); ok {
+		c.recount()
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c recountingClient) Status() client.SubResourceWriter {
+	return recountingStatusWriter{SubResourceWriter: c.Client.Status(), recount: c.recount}
+}
+
+type recountingStatusWriter struct {
+	client.SubResourceWriter
+	recount func()
+}
+
+func (w recountingStatusWriter) Update(
+	ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption,
+) error {
+	if access, ok := obj.(*cosiapi.BucketAccess); ok && len(access.Status.AccessedBuckets) > 0 {
+		w.recount()
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
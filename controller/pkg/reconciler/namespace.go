@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
+	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+)
+
+// NamespaceAllowed returns true if a class's allowedNamespaces selector matches the namespace.
+// A nil or empty selector matches all namespaces without looking up the Namespace.
+func NamespaceAllowed(
+	ctx context.Context, c client.Reader, allowedNamespaces *meta.LabelSelector, namespace string,
+) (bool, error) {
+	if allowedNamespaces == nil {
+		return true, nil
+	}
+
+	selector, err := meta.LabelSelectorAsSelector(allowedNamespaces)
+	if err != nil {
+		return false, fmt.Errorf("invalid allowedNamespaces selector: %w", err)
+	}
+	if selector.Empty() {
+		return true, nil
+	}
+
+	ns := &corev1.Namespace{}
+	if err := c.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, fmt.Errorf("failed to get Namespace %q: %w", namespace, err)
+	}
+	return selector.Matches(labels.Set(ns.Labels)), nil
+}
+
+// Return an error if the class may not be used from the namespace. Neither a namespace that isn't
+// allowed nor an invalid selector can be fixed by retrying. Namespace label changes are watched.
+func checkNamespaceAllowed(
+	ctx context.Context,
+	c client.Reader,
+	classKind string,
+	className string,
+	allowedNamespaces *meta.LabelSelector,
+	namespace string,
+) error {
+	if allowedNamespaces == nil {
+		return nil
+	}
+	if _, err := meta.LabelSelectorAsSelector(allowedNamespaces); err != nil {
+		return cosierr.NonRetryableError(
+			fmt.Errorf("%s %q has an invalid allowedNamespaces selector: %w", classKind, className, err))
+	}
+
+	allowed, err := NamespaceAllowed(ctx, c, allowedNamespaces, namespace)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return cosierr.NonRetryableError(cosiconditions.WithReason(cosiapi.ReasonNamespaceNotAllowed,
+			fmt.Errorf("%s %q may not be used from Namespace %q", classKind, className, namespace)))
+	}
+	return nil
+}
+
+// Return the name of the namespace an object is in, or the name of a Namespace object itself.
+func namespaceOf(obj client.Object) string {
+	if _, ok := obj.(*corev1.Namespace); ok {
+		return obj.GetName()
+	}
+	return obj.GetNamespace()
+}
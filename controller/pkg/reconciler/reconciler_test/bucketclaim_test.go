@@ -21,8 +21,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -146,17 +148,20 @@ func dynamicInitializationTest(t *testing.T) (
 		baseDynamicClaim.DeepCopy(),
 		baseClass.DeepCopy(),
 	)
+	recorder := events.NewFakeRecorder(10)
 	r := controller.BucketClaimReconciler{
-		Client: bootstrapped.Client,
-		Scheme: bootstrapped.Client.Scheme(),
+		Client:   bootstrapped.Client,
+		Scheme:   bootstrapped.Client.Scheme(),
+		Recorder: recorder,
 	}
 	ctx := bootstrapped.ContextWithLogger
 
 	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
-	assert.Error(t, err) // TODO: should be NoError when Bucket watcher is set up
-	assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
-	assert.ErrorContains(t, err, "waiting for Bucket to be provisioned")
+	assert.NoError(t, err) // Bucket watch enqueues the claim when the Bucket is provisioned
 	assert.Empty(t, res)
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, `Normal BucketCreated created Bucket "bc-dynamicuid" from BucketClass "s3-class"`, <-recorder.Events)
+	assert.Equal(t, `Normal Bound bound to Bucket "bc-dynamicuid"`, <-recorder.Events)
 
 	claim, bucket, _ := getAllResources(bootstrapped)
 
@@ -165,9 +170,7 @@ func dynamicInitializationTest(t *testing.T) (
 	assert.Equal(t, "bc-dynamicuid", status.BoundBucketName)
 	assert.Equal(t, false, *status.ReadyToUse)
 	assert.Empty(t, status.Protocols)
-	require.NotNil(t, status.Error)
-	assert.NotNil(t, status.Error.Time)
-	assert.Contains(t, *status.Error.Message, "waiting for Bucket to be provisioned")
+	assert.Nil(t, status.Error)
 
 	// intermediate bucket generation is already thoroughly tested elsewhere
 	// just test a couple basic fields to ensure it's integrated
@@ -203,9 +206,7 @@ func staticInitializationTest(
 	ctx := bootstrapped.ContextWithLogger
 
 	res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseStaticClaim)})
-	assert.Error(t, err) // TODO: should be NoError when Bucket watcher is set up
-	assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
-	assert.ErrorContains(t, err, "waiting for Bucket to be provisioned")
+	assert.NoError(t, err) // Bucket watch enqueues the claim when the Bucket is provisioned
 	assert.Empty(t, res)
 
 	claim, dynamicBucket, staticBucket := getAllResources(bootstrapped)
@@ -214,9 +215,8 @@ func staticInitializationTest(
 	assert.Equal(t, "static-bucket", claim.Status.BoundBucketName)
 	assert.Equal(t, false, *claim.Status.ReadyToUse)
 	assert.Empty(t, claim.Status.Protocols)
-	// claim status should record waiting state
-	require.NotNil(t, claim.Status.Error)
-	assert.Contains(t, *claim.Status.Error.Message, "waiting for Bucket to be provisioned")
+	// waiting for the Bucket to be provisioned is not an error
+	assert.Nil(t, claim.Status.Error)
 
 	// Bucket claim ref UID must match claim UID
 	// set either by controller or by admin
@@ -286,8 +286,7 @@ func TestBucketClaimReconcile(t *testing.T) {
 					initClaim, initBucket := test.getResourcesFunc(bootstrapped)
 
 					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
-					assert.Error(t, err) // TODO: should be NoError when Bucket watcher is set up
-					assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
+					assert.NoError(t, err) // Bucket watch enqueues the claim when the Bucket is provisioned
 					assert.Empty(t, res)
 
 					claim, bucket := test.getResourcesFunc(bootstrapped)
@@ -354,15 +353,26 @@ func TestBucketClaimReconcile(t *testing.T) {
 					initClaim, initBucket := test.getResourcesFunc(bootstrapped)
 
 					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
-					assert.Error(t, err) // TODO: should be NoError when Bucket watcher is set up
-					assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
+					assert.NoError(t, err) // Bucket watch enqueues the claim when the Bucket is provisioned
 					assert.Empty(t, res)
 
 					claim, bucket := test.getResourcesFunc(bootstrapped)
 
-					// claim should be unchanged since bucket is not ready
+					// claim should be unchanged since bucket is not ready, apart from mirroring the
+					// Bucket's provisioning error in conditions
 					assert.Equal(t, initClaim.Finalizers, claim.Finalizers)
 					assert.Equal(t, initClaim.Spec, claim.Spec)
+					provisioned := apimeta.FindStatusCondition(claim.Status.Conditions, cosiapi.ConditionProvisioned)
+					require.NotNil(t, provisioned)
+					assert.Equal(t, metav1.ConditionFalse, provisioned.Status)
+					assert.Equal(t, cosiapi.ReasonReconcileError, provisioned.Reason)
+					assert.Contains(t, provisioned.Message, "GCS")
+					ready := apimeta.FindStatusCondition(claim.Status.Conditions, cosiapi.ConditionReady)
+					require.NotNil(t, ready)
+					assert.Equal(t, provisioned.Reason, ready.Reason)
+					assert.True(t, apimeta.IsStatusConditionTrue(claim.Status.Conditions, cosiapi.ConditionBound))
+					initClaim.Status.Conditions = nil
+					claim.Status.Conditions = nil
 					assert.Equal(t, initClaim.Status, claim.Status)
 
 					assert.Equal(t, initBucket.Spec, bucket.Spec)
@@ -373,6 +383,116 @@ func TestBucketClaimReconcile(t *testing.T) {
 					assert.Len(t, buckets.Items, 1) // no other bucket should be created
 				})
 
+				t.Run("deletion after Bucket ready", func(t *testing.T) {
+					bootstrapped := initBootstrapped.MustCopy() // copy prior test world state
+					ctx := bootstrapped.ContextWithLogger
+					r := reconcilerForClient(bootstrapped.Client)
+
+					_, initBucket := test.getResourcesFunc(bootstrapped)
+					initBucket, err := sidecartest.ReconcileOpinionatedS3Bucket(t, bootstrapped, cositest.NsName(initBucket))
+					require.NoError(t, err)
+					require.Contains(t, initBucket.GetFinalizers(), cosiapi.ProtectionFinalizer)
+
+					_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+					require.NoError(t, err)
+
+					claim, _ := test.getResourcesFunc(bootstrapped)
+					require.NoError(t, r.Delete(ctx, claim))
+
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+					assert.Empty(t, res)
+
+					claim, bucket := test.getResourcesFunc(bootstrapped)
+					require.NotNil(t, bucket)
+					assert.Contains(t, bucket.GetAnnotations(), cosiapi.BucketClaimBeingDeletedAnnotation)
+					assert.Equal(t, initBucket.Spec, bucket.Spec)
+					assert.Equal(t, initBucket.Status, bucket.Status)
+
+					if bucket.Name != baseStaticBucket.Name {
+						// dynamic Bucket with Delete policy: wait for Sidecar to delete the Bucket
+						assert.Error(t, err)
+						assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
+						assert.ErrorContains(t, err, "waiting for intermediate Bucket")
+						assert.False(t, bucket.DeletionTimestamp.IsZero())
+						assert.NotContains(t, bucket.GetAnnotations(), cosiapi.BucketReleasedAnnotation)
+						require.NotNil(t, claim)
+						assert.Contains(t, claim.GetFinalizers(), cosiapi.ProtectionFinalizer)
+						require.NotNil(t, claim.Status.Error)
+						assert.Contains(t, *claim.Status.Error.Message, "waiting for intermediate Bucket")
+
+						// simulate Sidecar finishing backend bucket deletion
+						bucket.Finalizers = []string{}
+						require.NoError(t, bootstrapped.Client.Update(ctx, bucket))
+
+						res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+						assert.NoError(t, err)
+						assert.Empty(t, res)
+
+						claim, bucket = test.getResourcesFunc(bootstrapped)
+						assert.Nil(t, bucket)
+					} else {
+						// static Bucket is released
+						assert.NoError(t, err)
+						assert.True(t, bucket.DeletionTimestamp.IsZero())
+						assert.Equal(t, initBucket.Finalizers, bucket.Finalizers)
+						assert.Contains(t, bucket.GetAnnotations(), cosiapi.BucketReleasedAnnotation)
+					}
+
+					assert.Nil(t, claim) // claim finished deleting
+				})
+
+				t.Run("deletion blocked by BucketAccess references", func(t *testing.T) {
+					bootstrapped := initBootstrapped.MustCopy() // copy prior test world state
+					ctx := bootstrapped.ContextWithLogger
+					r := reconcilerForClient(bootstrapped.Client)
+					recorder := events.NewFakeRecorder(10)
+					r.Recorder = recorder
+
+					claim, _ := test.getResourcesFunc(bootstrapped)
+					claim.Annotations = map[string]string{cosiapi.HasBucketAccessReferencesAnnotation: ""}
+					require.NoError(t, bootstrapped.Client.Update(ctx, claim))
+					require.NoError(t, r.Delete(ctx, claim))
+
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+					assert.Error(t, err)
+					assert.NotErrorIs(t, err, reconcile.TerminalError(nil))
+					assert.ErrorContains(t, err, "waiting for BucketAccesses")
+					assert.Empty(t, res)
+
+					claim, bucket := test.getResourcesFunc(bootstrapped)
+					require.NotNil(t, claim)
+					assert.Contains(t, claim.GetFinalizers(), cosiapi.ProtectionFinalizer)
+					require.NotNil(t, claim.Status.Error)
+					assert.Contains(t, *claim.Status.Error.Message, "waiting for BucketAccesses")
+					blocked := apimeta.FindStatusCondition(claim.Status.Conditions, cosiapi.ConditionDeletionBlocked)
+					require.NotNil(t, blocked)
+					assert.Equal(t, metav1.ConditionTrue, blocked.Status)
+					assert.Equal(t, cosiapi.ReasonBucketAccessesExist, blocked.Reason)
+					require.Len(t, recorder.Events, 1)
+					assert.Equal(t, "Warning DeletionBlocked "+*claim.Status.Error.Message, <-recorder.Events)
+
+					// Bucket is marked so no new accesses are provisioned, but it isn't deleted yet
+					require.NotNil(t, bucket)
+					assert.Contains(t, bucket.GetAnnotations(), cosiapi.BucketClaimBeingDeletedAnnotation)
+					assert.True(t, bucket.DeletionTimestamp.IsZero())
+
+					// last BucketAccess reference removed
+					delete(claim.Annotations, cosiapi.HasBucketAccessReferencesAnnotation)
+					require.NoError(t, bootstrapped.Client.Update(ctx, claim))
+
+					res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+					assert.NoError(t, err)
+					assert.Empty(t, res)
+
+					claim, bucket = test.getResourcesFunc(bootstrapped)
+					assert.Nil(t, claim)
+					if test.name == "dynamic provisioning" {
+						assert.Nil(t, bucket) // Bucket without Sidecar finalizer is deleted immediately
+					} else {
+						assert.NotNil(t, bucket) // static Bucket is released
+					}
+				})
+
 				t.Run("err bucket force-deleted", func(t *testing.T) {
 					// note: this is also equivalent to the BucketClaim `boundBucketName` not
 					// matching the previous/intended bucket.
@@ -385,7 +505,7 @@ func TestBucketClaimReconcile(t *testing.T) {
 					require.NoError(t, r.Delete(ctx, initBucket)) // simulate force-delete of bucket while claim is bound
 
 					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
-					assert.Error(t, err) // TODO: should be NoError when Bucket watcher is set up
+					assert.Error(t, err)
 					assert.ErrorIs(t, err, reconcile.TerminalError(nil))
 					assert.ErrorContains(t, err, "unrecoverable degradation")
 					assert.ErrorContains(t, err, "no longer exists")
@@ -409,6 +529,55 @@ func TestBucketClaimReconcile(t *testing.T) {
 	})
 
 	t.Run("dynamic provisioning", func(t *testing.T) {
+		t.Run("deletion with Retain policy", func(t *testing.T) {
+			retainClass := baseClass.DeepCopy()
+			retainClass.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyRetain
+			bootstrapped := cositest.MustBootstrap(t,
+				baseDynamicClaim.DeepCopy(),
+				retainClass,
+			)
+			r := reconcilerForClient(bootstrapped.Client)
+			ctx := bootstrapped.ContextWithLogger
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+			require.NoError(t, err)
+
+			claim, _, _ := getAllResources(bootstrapped)
+			require.NoError(t, r.Delete(ctx, claim))
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+
+			claim, bucket, _ := getAllResources(bootstrapped)
+			assert.Nil(t, claim)
+			require.NotNil(t, bucket) // Bucket is released
+			assert.True(t, bucket.DeletionTimestamp.IsZero())
+			assert.Contains(t, bucket.GetAnnotations(), cosiapi.BucketClaimBeingDeletedAnnotation)
+			assert.Contains(t, bucket.GetAnnotations(), cosiapi.BucketReleasedAnnotation)
+		})
+
+		t.Run("deletion before Bucket is created", func(t *testing.T) {
+			claim := baseDynamicClaim.DeepCopy()
+			claim.Finalizers = []string{cosiapi.ProtectionFinalizer}
+			bootstrapped := cositest.MustBootstrap(t,
+				claim,
+				// no bucketclass
+			)
+			r := reconcilerForClient(bootstrapped.Client)
+			ctx := bootstrapped.ContextWithLogger
+
+			require.NoError(t, r.Delete(ctx, claim))
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseDynamicClaim)})
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+
+			claim, bucket, _ := getAllResources(bootstrapped)
+			assert.Nil(t, claim)
+			assert.Nil(t, bucket)
+		})
+
 		t.Run("err no bucketclass", func(t *testing.T) {
 			bootstrapped := cositest.MustBootstrap(t,
 				baseDynamicClaim.DeepCopy(),
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// BucketClaimDefaulter sets the default BucketClass for new BucketClaims that specify neither a
+// BucketClass nor an existing Bucket. See cosiapi.IsDefaultClassAnnotation.
+type BucketClaimDefaulter struct {
+	Client client.Reader
+}
+
+var _ admission.Defaulter[*cosiapi.BucketClaim] = &BucketClaimDefaulter{}
+
+// SetupWithManager registers the webhook with the Manager's webhook server.
+func (d *BucketClaimDefaulter) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &cosiapi.BucketClaim{}).WithDefaulter(d).Complete()
+}
+
+// Default implements admission.Defaulter.
+func (d *BucketClaimDefaulter) Default(ctx context.Context, claim *cosiapi.BucketClaim) error {
+	if claim.Spec.BucketClassName != "" || claim.Spec.ExistingBucketName != "" {
+		return nil
+	}
+
+	name, err := defaultClassName(ctx, d.Client, &cosiapi.BucketClassList{}, "BucketClass")
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		ctrl.LoggerFrom(ctx).V(1).Info("using default BucketClass", "bucketClassName", name)
+		claim.Spec.BucketClassName = name
+	}
+	return nil
+}
+
+// BucketAccessDefaulter sets the default BucketAccessClass for new BucketAccesses that don't
+// specify a BucketAccessClass. See cosiapi.IsDefaultClassAnnotation.
+type BucketAccessDefaulter struct {
+	Client client.Reader
+}
+
+var _ admission.Defaulter[*cosiapi.BucketAccess] = &BucketAccessDefaulter{}
+
+// SetupWithManager registers the webhook with the Manager's webhook server.
+func (d *BucketAccessDefaulter) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &cosiapi.BucketAccess{}).WithDefaulter(d).Complete()
+}
+
+// Default implements admission.Defaulter.
+func (d *BucketAccessDefaulter) Default(ctx context.Context, access *cosiapi.BucketAccess) error {
+	if access.Spec.BucketAccessClassName != "" {
+		return nil
+	}
+
+	name, err := defaultClassName(ctx, d.Client, &cosiapi.BucketAccessClassList{}, "BucketAccessClass")
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		ctrl.LoggerFrom(ctx).V(1).Info("using default BucketAccessClass", "bucketAccessClassName", name)
+		access.Spec.BucketAccessClassName = name
+	}
+	return nil
+}
+
+// Return the name of the class of the given kind that is marked as the default, or an empty string
+// if no class is the default. It is an error if more than one class is the default.
+func defaultClassName(ctx context.Context, c client.Reader, list client.ObjectList, kind string) (string, error) {
+	if err := c.List(ctx, list); err != nil {
+		return "", fmt.Errorf("failed to list %ss to find the default: %w", kind, err)
+	}
+
+	defaults := []string{}
+	err := apimeta.EachListItem(list, func(o runtime.Object) error {
+		class, err := apimeta.Accessor(o)
+		if err != nil {
+			return err
+		}
+		isDefault := class.GetAnnotations()[cosiapi.IsDefaultClassAnnotation] == "true"
+		if isDefault && class.GetDeletionTimestamp().IsZero() {
+			defaults = append(defaults, class.GetName())
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if len(defaults) > 1 {
+		slices.Sort(defaults)
+		return "", fmt.Errorf("cannot choose a default %s: %d are marked as the default %v, but only one may be",
+			kind, len(defaults), defaults)
+	}
+	if len(defaults) == 1 {
+		return defaults[0], nil
+	}
+	return "", nil
+}
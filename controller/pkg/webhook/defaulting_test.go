@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+)
+
+func TestBucketClaimDefaulter(t *testing.T) {
+	bucketClass := func(name, isDefault string) *cosiapi.BucketClass {
+		c := &cosiapi.BucketClass{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+			Spec:       cosiapi.BucketClassSpec{DriverName: "cosi.s3.internal"},
+		}
+		if isDefault != "" {
+			c.Annotations = map[string]string{cosiapi.IsDefaultClassAnnotation: isDefault}
+		}
+		return c
+	}
+	deletingDefault := bucketClass("deleting", "true")
+	deletingDefault.DeletionTimestamp = &meta.Time{Time: time.Now()}
+	deletingDefault.Finalizers = []string{"test"}
+
+	tests := []struct {
+		name      string
+		classes   []client.Object
+		spec      cosiapi.BucketClaimSpec
+		wantClass string
+		wantErr   string
+	}{
+		{"no default", []client.Object{bucketClass("a", ""), bucketClass("b", "false")},
+			cosiapi.BucketClaimSpec{}, "", ""},
+		{"one default", []client.Object{bucketClass("a", ""), bucketClass("b", "true"), deletingDefault},
+			cosiapi.BucketClaimSpec{}, "b", ""},
+		{"class specified", []client.Object{bucketClass("b", "true")},
+			cosiapi.BucketClaimSpec{BucketClassName: "a"}, "a", ""},
+		{"static provisioning", []client.Object{bucketClass("b", "true")},
+			cosiapi.BucketClaimSpec{ExistingBucketName: "static"}, "", ""},
+		{"multiple defaults", []client.Object{bucketClass("c", "true"), bucketClass("b", "true")},
+			cosiapi.BucketClaimSpec{}, "",
+			"cannot choose a default BucketClass: 2 are marked as the default [b c], but only one may be"},
+		{"multiple defaults, class specified", []client.Object{bucketClass("c", "true"), bucketClass("b", "true")},
+			cosiapi.BucketClaimSpec{BucketClassName: "a"}, "a", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapped := cositest.MustBootstrap(t, tt.classes...)
+			d := &BucketClaimDefaulter{Client: bootstrapped.Client}
+
+			claim := &cosiapi.BucketClaim{
+				ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-claim"},
+				Spec:       tt.spec,
+			}
+			err := d.Default(bootstrapped.ContextWithLogger, claim)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantClass, claim.Spec.BucketClassName)
+		})
+	}
+}
+
+func TestBucketAccessDefaulter(t *testing.T) {
+	accessClass := func(name, isDefault string) *cosiapi.BucketAccessClass {
+		c := &cosiapi.BucketAccessClass{
+			ObjectMeta: meta.ObjectMeta{Name: name},
+			Spec: cosiapi.BucketAccessClassSpec{
+				DriverName:         "cosi.s3.internal",
+				AuthenticationType: cosiapi.BucketAccessAuthenticationTypeKey,
+			},
+		}
+		if isDefault != "" {
+			c.Annotations = map[string]string{cosiapi.IsDefaultClassAnnotation: isDefault}
+		}
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		classes   []client.Object
+		className string
+		wantClass string
+		wantErr   string
+	}{
+		{"no default", []client.Object{accessClass("a", "")}, "", "", ""},
+		{"one default", []client.Object{accessClass("a", ""), accessClass("b", "true")}, "", "b", ""},
+		{"class specified", []client.Object{accessClass("b", "true")}, "a", "a", ""},
+		{"multiple defaults", []client.Object{accessClass("a", "true"), accessClass("b", "true")}, "", "",
+			"cannot choose a default BucketAccessClass: 2 are marked as the default [a b], but only one may be"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapped := cositest.MustBootstrap(t, tt.classes...)
+			d := &BucketAccessDefaulter{Client: bootstrapped.Client}
+
+			access := &cosiapi.BucketAccess{
+				ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-access"},
+				Spec:       cosiapi.BucketAccessSpec{BucketAccessClassName: tt.className},
+			}
+			err := d.Default(bootstrapped.ContextWithLogger, access)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantClass, access.Spec.BucketAccessClassName)
+		})
+	}
+}
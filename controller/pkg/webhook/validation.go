@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook implements COSI Controller admission webhooks.
+//
+// CEL rules in the COSI API types validate each object on its own. Validating webhooks check
+// BucketClaims and BucketAccesses against other resources when they are created, so that problems
+// the Controller would otherwise only report asynchronously in status are reported to the user
+// immediately. Defaulting webhooks fill in the default BucketClass or BucketAccessClass for new
+// BucketClaims and BucketAccesses that don't name one.
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	"sigs.k8s.io/container-object-storage-interface/controller/pkg/reconciler"
+)
+
+// ValidationMode determines how validation failures are reported to users.
+type ValidationMode string
+
+const (
+	// ValidationModeDeny rejects invalid resources.
+	ValidationModeDeny ValidationMode = "Deny"
+
+	// ValidationModeWarn admits invalid resources with a warning for each validation failure.
+	// The Controller reports the same problems in the resource's status.
+	ValidationModeWarn ValidationMode = "Warn"
+)
+
+// ParseValidationMode returns the validation mode with the given name (case-insensitive).
+func ParseValidationMode(s string) (ValidationMode, error) {
+	for _, m := range []ValidationMode{ValidationModeDeny, ValidationModeWarn} {
+		if strings.EqualFold(s, string(m)) {
+			return m, nil
+		}
+	}
+	return "", fmt.Errorf("unknown validation mode %q: must be %q or %q", s, ValidationModeDeny, ValidationModeWarn)
+}
+
+// Report validation failures for the object according to the validation mode. Errors looking up
+// other resources never deny the request. The Controller still validates the object when it
+// reconciles it.
+func (m ValidationMode) result(
+	kind string, name string, errs field.ErrorList, lookupErrs []error,
+) (admission.Warnings, error) {
+	warnings := admission.Warnings{}
+	for _, err := range lookupErrs {
+		warnings = append(warnings, fmt.Sprintf("unable to fully validate %s: %v", kind, err))
+	}
+
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+
+	if m == ValidationModeWarn {
+		for _, e := range errs {
+			warnings = append(warnings, e.Error())
+		}
+		return warnings, nil
+	}
+
+	gk := cosiapi.GroupVersion.WithKind(kind).GroupKind()
+	return warnings, kerrors.NewInvalid(gk, name, errs)
+}
+
+// BucketClaimValidator validates new BucketClaims against cluster resources.
+type BucketClaimValidator struct {
+	Client client.Reader
+	Mode   ValidationMode
+}
+
+var _ admission.Validator[*cosiapi.BucketClaim] = &BucketClaimValidator{}
+
+// SetupWithManager registers the webhook with the Manager's webhook server.
+func (v *BucketClaimValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &cosiapi.BucketClaim{}).WithValidator(v).Complete()
+}
+
+// ValidateCreate checks that the BucketClaim's BucketClass exists and may be used from the
+// BucketClaim's namespace.
+func (v *BucketClaimValidator) ValidateCreate(
+	ctx context.Context, claim *cosiapi.BucketClaim,
+) (admission.Warnings, error) {
+	errs := field.ErrorList{}
+	lookupErrs := []error{}
+
+	if className := claim.Spec.BucketClassName; className != "" {
+		path := field.NewPath("spec", "bucketClassName")
+		class := &cosiapi.BucketClass{}
+		if err := v.Client.Get(ctx, types.NamespacedName{Name: className}, class); err != nil {
+			if kerrors.IsNotFound(err) {
+				errs = append(errs, field.NotFound(path, className))
+			} else {
+				lookupErrs = append(lookupErrs, fmt.Errorf("failed to get BucketClass %q: %w", className, err))
+			}
+		} else {
+			nsErrs, err := validateNamespaceAllowed(ctx, v.Client, path, "BucketClass", className,
+				class.Spec.AllowedNamespaces, claim.Namespace)
+			errs = append(errs, nsErrs...)
+			if err != nil {
+				lookupErrs = append(lookupErrs, err)
+			}
+		}
+	}
+
+	return v.Mode.result("BucketClaim", claim.Name, errs, lookupErrs)
+}
+
+// ValidateUpdate allows all updates. The BucketClaim spec is immutable.
+func (v *BucketClaimValidator) ValidateUpdate(
+	_ context.Context, _, _ *cosiapi.BucketClaim,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete allows all deletions.
+func (v *BucketClaimValidator) ValidateDelete(_ context.Context, _ *cosiapi.BucketClaim) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// BucketAccessValidator validates new BucketAccesses against cluster resources.
+type BucketAccessValidator struct {
+	Client client.Reader
+	Mode   ValidationMode
+}
+
+var _ admission.Validator[*cosiapi.BucketAccess] = &BucketAccessValidator{}
+
+// SetupWithManager registers the webhook with the Manager's webhook server.
+func (v *BucketAccessValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &cosiapi.BucketAccess{}).WithValidator(v).Complete()
+}
+
+// ValidateCreate checks that the BucketAccess's access Secret names are unique, and that the
+// BucketAccess meets the requirements of its BucketAccessClass, including allowed namespaces.
+func (v *BucketAccessValidator) ValidateCreate(
+	ctx context.Context, access *cosiapi.BucketAccess,
+) (admission.Warnings, error) {
+	errs := field.ErrorList{}
+	lookupErrs := []error{}
+
+	dupErrs, err := v.validateAccessSecretNames(ctx, access)
+	errs = append(errs, dupErrs...)
+	if err != nil {
+		lookupErrs = append(lookupErrs, err)
+	}
+
+	className := access.Spec.BucketAccessClassName
+	class := &cosiapi.BucketAccessClass{}
+	if err := v.Client.Get(ctx, types.NamespacedName{Name: className}, class); err != nil {
+		if kerrors.IsNotFound(err) {
+			errs = append(errs, field.NotFound(field.NewPath("spec", "bucketAccessClassName"), className))
+		} else {
+			lookupErrs = append(lookupErrs, fmt.Errorf("failed to get BucketAccessClass %q: %w", className, err))
+		}
+	} else {
+		if err := reconciler.ValidateAccessAgainstClass(&class.Spec, &access.Spec); err != nil {
+			errs = append(errs, field.Forbidden(field.NewPath("spec"), err.Error()))
+		}
+		nsErrs, err := validateNamespaceAllowed(ctx, v.Client, field.NewPath("spec", "bucketAccessClassName"),
+			"BucketAccessClass", className, class.Spec.AllowedNamespaces, access.Namespace)
+		errs = append(errs, nsErrs...)
+		if err != nil {
+			lookupErrs = append(lookupErrs, err)
+		}
+	}
+
+	return v.Mode.result("BucketAccess", access.Name, errs, lookupErrs)
+}
+
+// ValidateUpdate allows all updates. The BucketAccess spec is immutable.
+func (v *BucketAccessValidator) ValidateUpdate(
+	_ context.Context, _, _ *cosiapi.BucketAccess,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// ValidateDelete allows all deletions.
+func (v *BucketAccessValidator) ValidateDelete(
+	_ context.Context, _ *cosiapi.BucketAccess,
+) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// Access Secret names must be unique within the BucketAccess and must not be used by other
+// BucketAccesses in the namespace. Otherwise, the Sidecar can't reserve the Secrets.
+func (v *BucketAccessValidator) validateAccessSecretNames(
+	ctx context.Context, access *cosiapi.BucketAccess,
+) (field.ErrorList, error) {
+	errs := field.ErrorList{}
+
+	others := &cosiapi.BucketAccessList{}
+	listErr := v.Client.List(ctx, others, client.InNamespace(access.Namespace))
+	if listErr != nil {
+		listErr = fmt.Errorf("failed to list BucketAccesses: %w", listErr)
+	}
+	usedBy := map[string]string{}
+	for _, other := range others.Items {
+		if other.Name == access.Name {
+			continue
+		}
+		for _, ref := range other.Spec.BucketClaims {
+			usedBy[ref.AccessSecretName] = other.Name
+		}
+	}
+
+	seen := map[string]bool{}
+	for i, ref := range access.Spec.BucketClaims {
+		path := field.NewPath("spec", "bucketClaims").Index(i).Child("accessSecretName")
+		name := ref.AccessSecretName
+		if seen[name] {
+			errs = append(errs, field.Duplicate(path, name))
+			continue
+		}
+		seen[name] = true
+		if other, ok := usedBy[name]; ok {
+			errs = append(errs, field.Invalid(path, name, fmt.Sprintf("already used by BucketAccess %q", other)))
+		}
+	}
+
+	return errs, listErr
+}
+
+// The class's allowedNamespaces selector must match the namespace of the resource using the class.
+func validateNamespaceAllowed(
+	ctx context.Context,
+	c client.Reader,
+	path *field.Path,
+	classKind string,
+	className string,
+	allowedNamespaces *meta.LabelSelector,
+	namespace string,
+) (field.ErrorList, error) {
+	allowed, err := reconciler.NamespaceAllowed(ctx, c, allowedNamespaces, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check %s %q allowed namespaces: %w", classKind, className, err)
+	}
+	if !allowed {
+		return field.ErrorList{field.Forbidden(path,
+			fmt.Sprintf("%s %q may not be used from Namespace %q", classKind, className, namespace))}, nil
+	}
+	return nil, nil
+}
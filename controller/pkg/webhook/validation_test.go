@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+)
+
+func TestParseValidationMode(t *testing.T) {
+	m, err := ParseValidationMode("deny")
+	assert.NoError(t, err)
+	assert.Equal(t, ValidationModeDeny, m)
+
+	m, err = ParseValidationMode("Warn")
+	assert.NoError(t, err)
+	assert.Equal(t, ValidationModeWarn, m)
+
+	_, err = ParseValidationMode("ignore")
+	assert.ErrorContains(t, err, `unknown validation mode "ignore"`)
+}
+
+func TestBucketClaimValidator(t *testing.T) {
+	class := &cosiapi.BucketClass{
+		ObjectMeta: meta.ObjectMeta{Name: "s3-class"},
+		Spec:       cosiapi.BucketClassSpec{DriverName: "cosi.s3.internal"},
+	}
+	premiumClass := &cosiapi.BucketClass{
+		ObjectMeta: meta.ObjectMeta{Name: "premium"},
+		Spec: cosiapi.BucketClassSpec{
+			DriverName:        "cosi.s3.internal",
+			AllowedNamespaces: &meta.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}},
+		},
+	}
+	ns := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{Name: "my-ns"}}
+	claim := func(className string) *cosiapi.BucketClaim {
+		return &cosiapi.BucketClaim{
+			ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-claim"},
+			Spec:       cosiapi.BucketClaimSpec{BucketClassName: className},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		mode         ValidationMode
+		claim        *cosiapi.BucketClaim
+		wantErr      string
+		wantWarnings []string
+	}{
+		{"class exists", ValidationModeDeny, claim("s3-class"), "", nil},
+		{"static provisioning", ValidationModeDeny,
+			&cosiapi.BucketClaim{Spec: cosiapi.BucketClaimSpec{ExistingBucketName: "static"}}, "", nil},
+		{"class not found, deny", ValidationModeDeny, claim("gold"),
+			`BucketClaim.objectstorage.k8s.io "my-claim" is invalid: spec.bucketClassName: Not found: "gold"`, nil},
+		{"class not found, warn", ValidationModeWarn, claim("gold"),
+			"", []string{`spec.bucketClassName: Not found: "gold"`}},
+		{"namespace not allowed", ValidationModeDeny, claim("premium"),
+			`BucketClaim.objectstorage.k8s.io "my-claim" is invalid: spec.bucketClassName: ` +
+				`Forbidden: BucketClass "premium" may not be used from Namespace "my-ns"`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapped := cositest.MustBootstrap(t, class.DeepCopy(), premiumClass.DeepCopy(), ns.DeepCopy())
+			v := &BucketClaimValidator{Client: bootstrapped.Client, Mode: tt.mode}
+
+			warnings, err := v.ValidateCreate(bootstrapped.ContextWithLogger, tt.claim)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.True(t, kerrors.IsInvalid(err))
+				assert.Equal(t, tt.wantErr, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.wantWarnings, warnings)
+
+			// specs are immutable, so updates aren't validated
+			warnings, err = v.ValidateUpdate(bootstrapped.ContextWithLogger, tt.claim, tt.claim)
+			assert.NoError(t, err)
+			assert.Empty(t, warnings)
+		})
+	}
+}
+
+func TestBucketAccessValidator(t *testing.T) {
+	singleBucketClass := &cosiapi.BucketAccessClass{
+		ObjectMeta: meta.ObjectMeta{Name: "single"},
+		Spec: cosiapi.BucketAccessClassSpec{
+			DriverName:                  "cosi.s3.internal",
+			AuthenticationType:          cosiapi.BucketAccessAuthenticationTypeKey,
+			MultiBucketAccess:           cosiapi.MultiBucketAccessSingleBucket,
+			DisallowedBucketAccessModes: []cosiapi.BucketAccessMode{cosiapi.BucketAccessModeWriteOnly},
+		},
+	}
+	multiBucketClass := &cosiapi.BucketAccessClass{
+		ObjectMeta: meta.ObjectMeta{Name: "multi"},
+		Spec: cosiapi.BucketAccessClassSpec{
+			DriverName:         "cosi.s3.internal",
+			AuthenticationType: cosiapi.BucketAccessAuthenticationTypeKey,
+			MultiBucketAccess:  cosiapi.MultiBucketAccessMultipleBuckets,
+		},
+	}
+	complianceClass := multiBucketClass.DeepCopy()
+	complianceClass.Name = "compliance"
+	complianceClass.Spec.AllowedNamespaces = &meta.LabelSelector{MatchLabels: map[string]string{"compliance": "pci"}}
+	ns := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{Name: "my-ns", Labels: map[string]string{"compliance": "pci"}}}
+	otherNs := &corev1.Namespace{ObjectMeta: meta.ObjectMeta{Name: "other-ns"}}
+	existingAccess := &cosiapi.BucketAccess{
+		ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "existing"},
+		Spec: cosiapi.BucketAccessSpec{
+			BucketAccessClassName: "multi",
+			BucketClaims: []cosiapi.BucketClaimAccess{
+				{BucketClaimName: "claim", AccessMode: cosiapi.BucketAccessModeReadOnly, AccessSecretName: "taken"},
+			},
+		},
+	}
+	otherNsAccess := existingAccess.DeepCopy()
+	otherNsAccess.Namespace = "other-ns"
+	otherNsAccess.Spec.BucketClaims[0].AccessSecretName = "other-ns-secret"
+
+	access := func(className string, claims ...cosiapi.BucketClaimAccess) *cosiapi.BucketAccess {
+		return &cosiapi.BucketAccess{
+			ObjectMeta: meta.ObjectMeta{Namespace: "my-ns", Name: "my-access"},
+			Spec:       cosiapi.BucketAccessSpec{BucketAccessClassName: className, BucketClaims: claims},
+		}
+	}
+	inNamespace := func(namespace string, a *cosiapi.BucketAccess) *cosiapi.BucketAccess {
+		a.Namespace = namespace
+		return a
+	}
+	ref := func(claim string, mode cosiapi.BucketAccessMode, secret string) cosiapi.BucketClaimAccess {
+		return cosiapi.BucketClaimAccess{BucketClaimName: claim, AccessMode: mode, AccessSecretName: secret}
+	}
+	ro, rw, wo := cosiapi.BucketAccessModeReadOnly, cosiapi.BucketAccessModeReadWrite, cosiapi.BucketAccessModeWriteOnly
+
+	tests := []struct {
+		name         string
+		mode         ValidationMode
+		access       *cosiapi.BucketAccess
+		wantErr      []string
+		wantWarnings []string
+	}{
+		{"valid single bucket", ValidationModeDeny,
+			access("single", ref("a", rw, "a-creds")), nil, nil},
+		{"valid multi bucket", ValidationModeDeny,
+			access("multi", ref("a", rw, "a-creds"), ref("b", wo, "b-creds"), ref("c", ro, "other-ns-secret")),
+			nil, nil},
+		{"namespace allowed", ValidationModeDeny,
+			access("compliance", ref("a", rw, "a-creds")), nil, nil},
+		{"namespace not allowed", ValidationModeDeny,
+			inNamespace("other-ns", access("compliance", ref("a", rw, "a-creds"))),
+			[]string{`spec.bucketAccessClassName: Forbidden: ` +
+				`BucketAccessClass "compliance" may not be used from Namespace "other-ns"`},
+			nil},
+		{"class not found", ValidationModeDeny,
+			access("gold", ref("a", rw, "a-creds")),
+			[]string{`spec.bucketAccessClassName: Not found: "gold"`}, nil},
+		{"duplicate accessSecretName", ValidationModeDeny,
+			access("multi", ref("a", rw, "creds"), ref("b", ro, "creds")),
+			[]string{`spec.bucketClaims[1].accessSecretName: Duplicate value: "creds"`}, nil},
+		{"accessSecretName used by another BucketAccess", ValidationModeDeny,
+			access("multi", ref("a", rw, "taken")),
+			[]string{`spec.bucketClaims[0].accessSecretName: Invalid value: "taken": already used by BucketAccess "existing"`},
+			nil},
+		{"multi-bucket access and mode disallowed", ValidationModeDeny,
+			access("single", ref("a", rw, "a-creds"), ref("b", wo, "b-creds")),
+			[]string{"multi-bucket access is disallowed", `accessMode "WriteOnly" requested for BucketClaim "b" is disallowed`},
+			nil},
+		{"multi-bucket access and duplicate, warn", ValidationModeWarn,
+			access("single", ref("a", rw, "creds"), ref("b", ro, "creds")),
+			nil,
+			[]string{
+				`spec.bucketClaims[1].accessSecretName: Duplicate value: "creds"`,
+				"spec: Forbidden: one or more features are disallowed by the BucketAccessClass: " +
+					"multi-bucket access is disallowed",
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs := []client.Object{
+				singleBucketClass.DeepCopy(), multiBucketClass.DeepCopy(), complianceClass.DeepCopy(),
+				existingAccess.DeepCopy(), otherNsAccess.DeepCopy(), ns.DeepCopy(), otherNs.DeepCopy(),
+			}
+			bootstrapped := cositest.MustBootstrap(t, objs...)
+			v := &BucketAccessValidator{Client: bootstrapped.Client, Mode: tt.mode}
+
+			warnings, err := v.ValidateCreate(bootstrapped.ContextWithLogger, tt.access)
+			if len(tt.wantErr) > 0 {
+				require.Error(t, err)
+				assert.True(t, kerrors.IsInvalid(err))
+				assert.Contains(t, err.Error(), `BucketAccess.objectstorage.k8s.io "my-access" is invalid`)
+				for _, want := range tt.wantErr {
+					assert.Contains(t, err.Error(), want)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.wantWarnings, warnings)
+		})
+	}
+}
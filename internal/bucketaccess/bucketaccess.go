@@ -21,6 +21,8 @@ package bucketaccess
 import (
 	"fmt"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
 )
 
@@ -105,3 +107,22 @@ func SidecarRequirementsPresent(s *cosiapi.BucketAccessStatus) (bool, error) {
 
 	return false, fmt.Errorf("fields required for sidecar provisioning are only partially set: %v", requiredFields)
 }
+
+// BucketClaimNameIndexKey is the name of the field index that maps BucketAccesses to the names of
+// all BucketClaims they reference in spec.bucketClaims. Names are unique only within a namespace,
+// so index lookups should also be limited to the BucketClaim's namespace.
+const BucketClaimNameIndexKey = "spec.bucketClaims.bucketClaimName"
+
+// IndexBucketClaimNames is the field indexer function for BucketClaimNameIndexKey.
+func IndexBucketClaimNames(obj client.Object) []string {
+	ba, ok := obj.(*cosiapi.BucketAccess)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(ba.Spec.BucketClaims))
+	for _, ref := range ba.Spec.BucketClaims {
+		names = append(names, ref.BucketClaimName)
+	}
+	return names
+}
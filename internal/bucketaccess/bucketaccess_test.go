@@ -152,3 +152,26 @@ func TestManagedBySidecar(t *testing.T) {
 		})
 	}
 }
+
+func TestIndexBucketClaimNames(t *testing.T) {
+	t.Run("multiple claims", func(t *testing.T) {
+		ba := &cosiapi.BucketAccess{
+			Spec: cosiapi.BucketAccessSpec{
+				BucketClaims: []cosiapi.BucketClaimAccess{
+					{BucketClaimName: "bc-1"},
+					{BucketClaimName: "bc-2"},
+				},
+			},
+		}
+
+		assert.Equal(t, []string{"bc-1", "bc-2"}, IndexBucketClaimNames(ba))
+	})
+
+	t.Run("no claims", func(t *testing.T) {
+		assert.Empty(t, IndexBucketClaimNames(&cosiapi.BucketAccess{}))
+	})
+
+	t.Run("not a BucketAccess", func(t *testing.T) {
+		assert.Nil(t, IndexBucketClaimNames(&cosiapi.BucketClaim{}))
+	})
+}
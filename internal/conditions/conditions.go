@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conditions manages standard metav1.Condition status on COSI resources.
+//
+// The COSI Controller and Sidecars each own different condition types on a resource so that they
+// do not overwrite each other's results. Both keep the Ready condition consistent with the
+// resource's status.readyToUse field.
+package conditions
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// maxMessageLength is the maximum length of a condition message allowed by the API server.
+const maxMessageLength = 32768
+
+// Condition types that can make a resource not ready, in order of precedence for reporting the
+// reason why the resource is not ready.
+var notReadyCauses = []string{
+	cosiapi.ConditionBound,
+	cosiapi.ConditionProvisioned,
+	cosiapi.ConditionAccessGranted,
+}
+
+// Set sets a condition for the object, with observedGeneration set to the object's generation.
+// The last transition time changes only if the condition status changes.
+func Set(
+	obj client.Object, conditions *[]meta.Condition,
+	condType string, condStatus meta.ConditionStatus, reason, message string,
+) {
+	if len(message) > maxMessageLength {
+		message = message[:maxMessageLength-3] + "..."
+	}
+	apimeta.SetStatusCondition(conditions, meta.Condition{
+		Type:               condType,
+		Status:             condStatus,
+		ObservedGeneration: obj.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// SetSucceeded sets a condition to True with reason Succeeded.
+func SetSucceeded(obj client.Object, conditions *[]meta.Condition, condType string) {
+	Set(obj, conditions, condType, meta.ConditionTrue, cosiapi.ReasonSucceeded, "")
+}
+
+// SetPending sets a condition to False with reason Pending and the given message.
+func SetPending(obj client.Object, conditions *[]meta.Condition, condType, message string) {
+	Set(obj, conditions, condType, meta.ConditionFalse, cosiapi.ReasonPending, message)
+}
+
+// SetError sets a condition to False with a reason derived from the error. See Reason().
+func SetError(obj client.Object, conditions *[]meta.Condition, condType string, err error) {
+	Set(obj, conditions, condType, meta.ConditionFalse, Reason(err), err.Error())
+}
+
+// SetDeletionBlocked sets the DeletionBlocked condition to True with a reason derived from the
+// error that blocks deletion. See Reason().
+func SetDeletionBlocked(obj client.Object, conditions *[]meta.Condition, err error) {
+	Set(obj, conditions, cosiapi.ConditionDeletionBlocked, meta.ConditionTrue, Reason(err), err.Error())
+}
+
+// SetReconcileError records a reconcile error in the object's conditions. The error sets the
+// DeletionBlocked condition for a deleting object, and otherwise sets the given condition type to
+// False. The Ready condition is updated to match readyToUse.
+func SetReconcileError(
+	obj client.Object, conditions *[]meta.Condition, condType string, readyToUse *bool, err error,
+) {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		SetDeletionBlocked(obj, conditions, err)
+	} else {
+		SetError(obj, conditions, condType, err)
+	}
+	SetReady(obj, conditions, readyToUse)
+}
+
+// SetReady sets the Ready condition based on the readyToUse status. If the object is not ready, the
+// reason and message are copied from the first False condition that can make the object not ready.
+func SetReady(obj client.Object, conditions *[]meta.Condition, readyToUse *bool) {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		Set(obj, conditions, cosiapi.ConditionReady, meta.ConditionFalse, cosiapi.ReasonDeleting, "")
+		return
+	}
+	if readyToUse != nil && *readyToUse {
+		SetSucceeded(obj, conditions, cosiapi.ConditionReady)
+		return
+	}
+	for _, t := range notReadyCauses {
+		if c := apimeta.FindStatusCondition(*conditions, t); c != nil && c.Status == meta.ConditionFalse {
+			Set(obj, conditions, cosiapi.ConditionReady, meta.ConditionFalse, c.Reason, c.Message)
+			return
+		}
+	}
+	SetPending(obj, conditions, cosiapi.ConditionReady, "")
+}
+
+// WithReason returns an error that reports the given condition reason via Reason().
+func WithReason(reason string, err error) error {
+	return &reasonError{reason: reason, err: err}
+}
+
+type reasonError struct {
+	reason string
+	err    error
+}
+
+func (e *reasonError) Error() string {
+	return e.err.Error()
+}
+
+func (e *reasonError) Unwrap() error {
+	return e.err
+}
+
+// Reason returns a machine-readable condition reason for an error.
+// Errors from WithReason() report their given reason.
+// Driver RPC errors report the gRPC status code prefixed with "Driver", e.g., DriverUnavailable.
+// All other errors report ReconcileError.
+func Reason(err error) string {
+	re := &reasonError{}
+	if errors.As(err, &re) {
+		return re.reason
+	}
+	if s, ok := status.FromError(err); ok && s.Code() <= codes.Unauthenticated {
+		// codes beyond the last known code aren't valid reasons (e.g., "Code(17)")
+		return cosiapi.ReasonDriverErrorPrefix + s.Code().String()
+	}
+	return cosiapi.ReasonReconcileError
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conditions
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+)
+
+func TestReason(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"plain error", fmt.Errorf("oops"), cosiapi.ReasonReconcileError},
+		{"rpc error", status.Error(codes.InvalidArgument, "bad"), "DriverInvalidArgument"},
+		{"wrapped rpc error",
+			fmt.Errorf("COSI Sidecar error: %w", cosierr.NonRetryableError(status.Error(codes.PermissionDenied, "no"))),
+			"DriverPermissionDenied"},
+		{"unknown rpc code", status.Error(codes.Code(99), "what"), cosiapi.ReasonReconcileError},
+		{"with reason", WithReason(cosiapi.ReasonReleased, fmt.Errorf("released")), cosiapi.ReasonReleased},
+		{"wrapped with reason",
+			fmt.Errorf("outer: %w", WithReason(cosiapi.ReasonReleased, status.Error(codes.Internal, "x"))),
+			cosiapi.ReasonReleased},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Reason(tt.err))
+		})
+	}
+}
+
+func TestSetReconcileError(t *testing.T) {
+	claim := &cosiapi.BucketClaim{ObjectMeta: meta.ObjectMeta{Generation: 3}}
+	conds := &claim.Status.Conditions
+
+	SetSucceeded(claim, conds, cosiapi.ConditionBound)
+	SetReconcileError(claim, conds, cosiapi.ConditionProvisioned, ptr.To(false),
+		status.Error(codes.Unavailable, "driver down"))
+
+	provisioned := apimeta.FindStatusCondition(*conds, cosiapi.ConditionProvisioned)
+	require.NotNil(t, provisioned)
+	assert.Equal(t, meta.ConditionFalse, provisioned.Status)
+	assert.Equal(t, "DriverUnavailable", provisioned.Reason)
+	assert.Equal(t, "rpc error: code = Unavailable desc = driver down", provisioned.Message)
+	assert.Equal(t, int64(3), provisioned.ObservedGeneration)
+
+	// Ready reports the reason from the failing condition
+	ready := apimeta.FindStatusCondition(*conds, cosiapi.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, meta.ConditionFalse, ready.Status)
+	assert.Equal(t, "DriverUnavailable", ready.Reason)
+	assert.Equal(t, provisioned.Message, ready.Message)
+	assert.Nil(t, apimeta.FindStatusCondition(*conds, cosiapi.ConditionDeletionBlocked))
+
+	// errors while deleting block deletion instead
+	now := meta.Now()
+	claim.DeletionTimestamp = &now
+	SetReconcileError(claim, conds, cosiapi.ConditionProvisioned, ptr.To(false),
+		WithReason(cosiapi.ReasonBucketAccessesExist, fmt.Errorf("waiting for BucketAccesses")))
+
+	blocked := apimeta.FindStatusCondition(*conds, cosiapi.ConditionDeletionBlocked)
+	require.NotNil(t, blocked)
+	assert.Equal(t, meta.ConditionTrue, blocked.Status)
+	assert.Equal(t, cosiapi.ReasonBucketAccessesExist, blocked.Reason)
+	assert.Equal(t, "DriverUnavailable", apimeta.FindStatusCondition(*conds, cosiapi.ConditionProvisioned).Reason)
+	ready = apimeta.FindStatusCondition(*conds, cosiapi.ConditionReady)
+	assert.Equal(t, cosiapi.ReasonDeleting, ready.Reason)
+}
+
+func TestSetReady(t *testing.T) {
+	access := &cosiapi.BucketAccess{ObjectMeta: meta.ObjectMeta{Generation: 1}}
+	conds := &access.Status.Conditions
+
+	SetReady(access, conds, nil)
+	ready := apimeta.FindStatusCondition(*conds, cosiapi.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, meta.ConditionFalse, ready.Status)
+	assert.Equal(t, cosiapi.ReasonPending, ready.Reason)
+
+	// Bound takes precedence over AccessGranted
+	SetPending(access, conds, cosiapi.ConditionAccessGranted, "waiting for grant")
+	SetError(access, conds, cosiapi.ConditionBound, fmt.Errorf("claim missing"))
+	SetReady(access, conds, ptr.To(false))
+	ready = apimeta.FindStatusCondition(*conds, cosiapi.ConditionReady)
+	assert.Equal(t, cosiapi.ReasonReconcileError, ready.Reason)
+	assert.Equal(t, "claim missing", ready.Message)
+
+	access.Generation = 2
+	SetSucceeded(access, conds, cosiapi.ConditionAccessGranted)
+	SetReady(access, conds, ptr.To(true))
+	ready = apimeta.FindStatusCondition(*conds, cosiapi.ConditionReady)
+	assert.Equal(t, meta.ConditionTrue, ready.Status)
+	assert.Equal(t, cosiapi.ReasonSucceeded, ready.Reason)
+	assert.Empty(t, ready.Message)
+	assert.Equal(t, int64(2), ready.ObservedGeneration)
+}
+
+func TestSet_longMessage(t *testing.T) {
+	claim := &cosiapi.BucketClaim{}
+	Set(claim, &claim.Status.Conditions, cosiapi.ConditionBound, meta.ConditionFalse, cosiapi.ReasonReconcileError,
+		strings.Repeat("x", 2*maxMessageLength))
+	c := apimeta.FindStatusCondition(claim.Status.Conditions, cosiapi.ConditionBound)
+	require.NotNil(t, c)
+	assert.Len(t, c.Message, maxMessageLength)
+	assert.True(t, strings.HasSuffix(c.Message, "..."))
+}
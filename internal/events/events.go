@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events records Kubernetes Events about COSI resources.
+//
+// Normal events mark lifecycle milestones. Warning events report reconcile errors using the same
+// reasons as status conditions (see conditions.Reason()) so that events and conditions agree.
+package events
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
+)
+
+// Event reasons for Normal events, and for Warning events not derived from an error.
+const (
+	ReasonBucketCreated      = "BucketCreated"
+	ReasonBound              = "Bound"
+	ReasonProvisioned        = "Provisioned"
+	ReasonHandedOff          = "HandedOff"
+	ReasonAccessGranted      = "AccessGranted"
+	ReasonCredentialsRotated = "CredentialsRotated"
+	ReasonAccessRevoked      = "AccessRevoked"
+	ReasonOrphaned           = "Orphaned"
+	ReasonDeletionBlocked    = "DeletionBlocked"
+)
+
+// Event actions describe what COSI did, or failed to do, regarding the object.
+const (
+	ActionReconcile = "Reconcile"
+	ActionCreate    = "Create"
+	ActionBind      = "Bind"
+	ActionProvision = "Provision"
+	ActionHandoff   = "Handoff"
+	ActionGrant     = "Grant"
+	ActionRotate    = "Rotate"
+	ActionRevoke    = "Revoke"
+	ActionDelete    = "Delete"
+)
+
+// maxNoteLength is the maximum length of an event note allowed by the API server.
+const maxNoteLength = 1024
+
+// Normal records a Normal event regarding an object. The related object may be nil.
+// No event is recorded if the recorder is nil.
+func Normal(
+	recorder events.EventRecorder, regarding, related runtime.Object, reason, action, note string, args ...any,
+) {
+	record(recorder, regarding, related, corev1.EventTypeNormal, reason, action, note, args...)
+}
+
+// Warning records a Warning event regarding an object. The related object may be nil.
+// No event is recorded if the recorder is nil.
+func Warning(
+	recorder events.EventRecorder, regarding, related runtime.Object, reason, action, note string, args ...any,
+) {
+	record(recorder, regarding, related, corev1.EventTypeWarning, reason, action, note, args...)
+}
+
+// ReconcileError records a Warning event for a reconcile error. Errors for a deleting object are
+// reported as DeletionBlocked. Other errors use the condition reason for the error.
+func ReconcileError(recorder events.EventRecorder, obj client.Object, err error) {
+	if !obj.GetDeletionTimestamp().IsZero() {
+		Warning(recorder, obj, nil, ReasonDeletionBlocked, ActionDelete, "%s", err.Error())
+		return
+	}
+	Warning(recorder, obj, nil, cosiconditions.Reason(err), ActionReconcile, "%s", err.Error())
+}
+
+func record(
+	recorder events.EventRecorder, regarding, related runtime.Object,
+	eventType, reason, action, note string, args ...any,
+) {
+	if recorder == nil {
+		return
+	}
+	note = fmt.Sprintf(note, args...)
+	if len(note) > maxNoteLength {
+		note = note[:maxNoteLength-3] + "..."
+	}
+	recorder.Eventf(regarding, related, eventType, reason, action, "%s", note)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+func TestReconcileError(t *testing.T) {
+	claim := &cosiapi.BucketClaim{ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "claim"}}
+
+	tests := []struct {
+		name     string
+		deleting bool
+		err      error
+		want     string
+	}{
+		{"plain error", false, fmt.Errorf("oops"), "Warning ReconcileError oops"},
+		{"driver error", false, status.Error(codes.Unavailable, "down"),
+			"Warning DriverUnavailable rpc error: code = Unavailable desc = down"},
+		{"deleting", true, status.Error(codes.Unavailable, "down"),
+			"Warning DeletionBlocked rpc error: code = Unavailable desc = down"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := claim.DeepCopy()
+			if tt.deleting {
+				obj.DeletionTimestamp = ptr.To(meta.Now())
+			}
+			recorder := events.NewFakeRecorder(1)
+			ReconcileError(recorder, obj, tt.err)
+			require.Len(t, recorder.Events, 1)
+			assert.Equal(t, tt.want, <-recorder.Events)
+		})
+	}
+}
+
+func TestNormal(t *testing.T) {
+	claim := &cosiapi.BucketClaim{ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "claim"}}
+
+	t.Run("nil recorder", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			Normal(nil, claim, nil, ReasonBound, ActionBind, "bound to Bucket %q", "bc-1")
+		})
+	})
+
+	t.Run("formats note", func(t *testing.T) {
+		recorder := events.NewFakeRecorder(1)
+		Normal(recorder, claim, nil, ReasonBound, ActionBind, "bound to Bucket %q", "bc-1")
+		assert.Equal(t, `Normal Bound bound to Bucket "bc-1"`, <-recorder.Events)
+	})
+
+	t.Run("long note", func(t *testing.T) {
+		recorder := events.NewFakeRecorder(1)
+		Normal(recorder, claim, nil, ReasonBound, ActionBind, "%s", strings.Repeat("x", 2*maxNoteLength))
+		e := strings.TrimPrefix(<-recorder.Events, "Normal Bound ")
+		assert.Len(t, e, maxNoteLength)
+		assert.True(t, strings.HasSuffix(e, "..."))
+	})
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// listTimeout limits how long a metrics scrape waits to list objects.
+const listTimeout = 10 * time.Second
+
+var (
+	bucketClaimsDesc = prometheus.NewDesc(BucketClaimsName,
+		"Number of BucketClaims by readiness, driver, and BucketClass.",
+		[]string{readyLabel, driverLabel, classLabel}, nil)
+	bucketsDesc = prometheus.NewDesc(BucketsName,
+		"Number of Buckets by readiness, driver, and BucketClass.",
+		[]string{readyLabel, driverLabel, classLabel}, nil)
+	bucketAccessesDesc = prometheus.NewDesc(BucketAccessesName,
+		"Number of BucketAccesses by readiness, driver, and BucketAccessClass.",
+		[]string{readyLabel, driverLabel, classLabel}, nil)
+	stuckDeletionsDesc = prometheus.NewDesc(StuckDeletionsName,
+		"Number of COSI resources that are deleting but still have finalizers.",
+		[]string{kindLabel}, nil)
+)
+
+// ObjectCollector is a prometheus.Collector that reports the number of COSI resources by state.
+// Objects are listed from the given reader each time metrics are collected, so the reader should be
+// backed by an informer cache.
+type ObjectCollector struct {
+	reader client.Reader
+	logger logr.Logger
+}
+
+// NewObjectCollector returns a collector for COSI resource state metrics. The caller must register
+// it with a Prometheus registry.
+func NewObjectCollector(reader client.Reader, logger logr.Logger) *ObjectCollector {
+	return &ObjectCollector{
+		reader: reader,
+		logger: logger,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *ObjectCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- bucketClaimsDesc
+	ch <- bucketsDesc
+	ch <- bucketAccessesDesc
+	ch <- stuckDeletionsDesc
+}
+
+// Collect implements prometheus.Collector.
+// Objects that can't be listed are logged and omitted so that other metrics are still served.
+func (c *ObjectCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+
+	claims := &cosiapi.BucketClaimList{}
+	claimsOk := c.list(ctx, claims, KindBucketClaim)
+	buckets := &cosiapi.BucketList{}
+	bucketsOk := c.list(ctx, buckets, KindBucket)
+	accesses := &cosiapi.BucketAccessList{}
+	accessesOk := c.list(ctx, accesses, KindBucketAccess)
+
+	// A Bucket has no class of its own. Use the BucketClass of the BucketClaim it is bound to.
+	claimClasses := map[client.ObjectKey]string{}
+	for _, claim := range claims.Items {
+		claimClasses[client.ObjectKeyFromObject(&claim)] = claim.Spec.BucketClassName
+	}
+	bucketDrivers := map[string]string{}
+	for _, bucket := range buckets.Items {
+		bucketDrivers[bucket.Name] = bucket.Spec.DriverName
+	}
+
+	if claimsOk {
+		counts := stateCounts{}
+		stuck := 0
+		for _, claim := range claims.Items {
+			// The driver isn't known until the BucketClaim is bound to a Bucket.
+			driver := bucketDrivers[claim.Status.BoundBucketName]
+			counts.add(claim.Status.ReadyToUse, driver, claim.Spec.BucketClassName)
+			stuck += stuckDeletion(&claim)
+		}
+		counts.collect(ch, bucketClaimsDesc)
+		ch <- prometheus.MustNewConstMetric(stuckDeletionsDesc, prometheus.GaugeValue, float64(stuck), KindBucketClaim)
+	}
+
+	if bucketsOk {
+		counts := stateCounts{}
+		stuck := 0
+		for _, bucket := range buckets.Items {
+			claimRef := bucket.Spec.BucketClaimRef
+			class := claimClasses[client.ObjectKey{Namespace: claimRef.Namespace, Name: claimRef.Name}]
+			counts.add(bucket.Status.ReadyToUse, bucket.Spec.DriverName, class)
+			stuck += stuckDeletion(&bucket)
+		}
+		counts.collect(ch, bucketsDesc)
+		ch <- prometheus.MustNewConstMetric(stuckDeletionsDesc, prometheus.GaugeValue, float64(stuck), KindBucket)
+	}
+
+	if accessesOk {
+		counts := stateCounts{}
+		stuck := 0
+		for _, access := range accesses.Items {
+			counts.add(access.Status.ReadyToUse, access.Status.DriverName, access.Spec.BucketAccessClassName)
+			stuck += stuckDeletion(&access)
+		}
+		counts.collect(ch, bucketAccessesDesc)
+		ch <- prometheus.MustNewConstMetric(stuckDeletionsDesc, prometheus.GaugeValue, float64(stuck), KindBucketAccess)
+	}
+}
+
+func (c *ObjectCollector) list(ctx context.Context, list client.ObjectList, kind string) bool {
+	if err := c.reader.List(ctx, list); err != nil {
+		c.logger.Error(err, "failed to list objects for metrics", "kind", kind)
+		return false
+	}
+	return true
+}
+
+// Returns 1 if the object is deleting but still has finalizers, and 0 otherwise.
+func stuckDeletion(obj client.Object) int {
+	if obj.GetDeletionTimestamp().IsZero() || len(obj.GetFinalizers()) == 0 {
+		return 0
+	}
+	return 1
+}
+
+// ready, driver, class label values
+type stateKey [3]string
+
+// counts of objects by state
+type stateCounts map[stateKey]int
+
+func (s stateCounts) add(readyToUse *bool, driver, class string) {
+	ready := "unknown"
+	if readyToUse != nil {
+		ready = strconv.FormatBool(*readyToUse)
+	}
+	s[stateKey{ready, driver, class}]++
+}
+
+func (s stateCounts) collect(ch chan<- prometheus.Metric, desc *prometheus.Desc) {
+	for key, count := range s {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(count), key[0], key[1], key[2])
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines COSI-specific Prometheus metrics that describe the provisioning lifecycle
+// of COSI resources. Lifecycle metrics are recorded by the COSI Controller and Sidecars as they
+// reconcile resources and are registered with the controller-runtime metrics registry.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Resource kinds used as the value of the `kind` label.
+const (
+	KindBucketClaim  = "BucketClaim"
+	KindBucket       = "Bucket"
+	KindBucketAccess = "BucketAccess"
+)
+
+// Metric names.
+const (
+	TimeToReadySecondsName = "cosi_time_to_ready_seconds"
+	NonRetryableErrorsName = "cosi_nonretryable_errors_total"
+	BucketClaimsName       = "cosi_bucketclaims"
+	BucketsName            = "cosi_buckets"
+	BucketAccessesName     = "cosi_bucketaccesses"
+	StuckDeletionsName     = "cosi_stuck_deletions"
+)
+
+// label names
+const (
+	kindLabel   = "kind"
+	driverLabel = "driver"
+	classLabel  = "class"
+	readyLabel  = "ready"
+)
+
+var (
+	timeToReady = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: TimeToReadySecondsName,
+		Help: "Time in seconds from COSI resource creation until it first becomes ready to use.",
+		// Provisioning may be nearly instant or may wait minutes for dependencies or retries.
+		Buckets: []float64{1, 2.5, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+	}, []string{kindLabel, driverLabel})
+
+	nonRetryableErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: NonRetryableErrorsName,
+		Help: "Total number of COSI resource reconciles that failed with a non-retryable error.",
+	}, []string{kindLabel, driverLabel})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(timeToReady, nonRetryableErrors)
+}
+
+// ObserveReady records the time from the object's creation until now as its time to become ready.
+// Callers must call this only when the object first transitions to ready.
+func ObserveReady(kind, driver string, obj client.Object) {
+	created := obj.GetCreationTimestamp()
+	if created.IsZero() {
+		return
+	}
+	timeToReady.WithLabelValues(kind, driver).Observe(time.Since(created.Time).Seconds())
+}
+
+// CountNonRetryableError records a reconcile that failed with a non-retryable error.
+// The driver name may be empty if it is not known when the error occurs.
+func CountNonRetryableError(kind, driver string) {
+	nonRetryableErrors.WithLabelValues(kind, driver).Inc()
+}
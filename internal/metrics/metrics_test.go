@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+)
+
+func TestObjectCollector(t *testing.T) {
+	deleting := meta.NewTime(time.Now())
+
+	claims := []cosiapi.BucketClaim{
+		{
+			ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "ready"},
+			Spec:       cosiapi.BucketClaimSpec{BucketClassName: "gold"},
+			Status:     cosiapi.BucketClaimStatus{BoundBucketName: "bc-ready", ReadyToUse: ptr.To(true)},
+		},
+		{
+			ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "unbound"},
+			Spec:       cosiapi.BucketClaimSpec{BucketClassName: "gold"},
+		},
+		{
+			ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "deleting",
+				DeletionTimestamp: &deleting, Finalizers: []string{cosiapi.ProtectionFinalizer}},
+			Spec:   cosiapi.BucketClaimSpec{BucketClassName: "gold"},
+			Status: cosiapi.BucketClaimStatus{BoundBucketName: "bc-deleting", ReadyToUse: ptr.To(false)},
+		},
+	}
+	buckets := []cosiapi.Bucket{
+		{
+			ObjectMeta: meta.ObjectMeta{Name: "bc-ready"},
+			Spec: cosiapi.BucketSpec{DriverName: "s3.cosi.test",
+				BucketClaimRef: cosiapi.BucketClaimReference{Namespace: "ns", Name: "ready"}},
+			Status: cosiapi.BucketStatus{ReadyToUse: ptr.To(true)},
+		},
+		{
+			ObjectMeta: meta.ObjectMeta{Name: "bc-deleting",
+				DeletionTimestamp: &deleting, Finalizers: []string{cosiapi.ProtectionFinalizer}},
+			Spec: cosiapi.BucketSpec{DriverName: "s3.cosi.test",
+				BucketClaimRef: cosiapi.BucketClaimReference{Namespace: "ns", Name: "deleting"}},
+			Status: cosiapi.BucketStatus{ReadyToUse: ptr.To(true)},
+		},
+		{
+			ObjectMeta: meta.ObjectMeta{Name: "static"},
+			Spec:       cosiapi.BucketSpec{DriverName: "s3.cosi.test"},
+		},
+	}
+	accesses := []cosiapi.BucketAccess{
+		{
+			ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "access"},
+			Spec:       cosiapi.BucketAccessSpec{BucketAccessClassName: "rw"},
+			Status:     cosiapi.BucketAccessStatus{DriverName: "s3.cosi.test", ReadyToUse: ptr.To(false)},
+		},
+	}
+
+	initObjs := []client.Object{}
+	for i := range claims {
+		initObjs = append(initObjs, &claims[i])
+	}
+	for i := range buckets {
+		initObjs = append(initObjs, &buckets[i])
+	}
+	for i := range accesses {
+		initObjs = append(initObjs, &accesses[i])
+	}
+	bootstrapped := cositest.MustBootstrap(t, initObjs...)
+
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(NewObjectCollector(bootstrapped.Client, bootstrapped.Logger)))
+	families := gather(t, registry)
+
+	assert.Equal(t, map[string]float64{
+		"class=gold,driver=s3.cosi.test,ready=true":  1,
+		"class=gold,driver=,ready=unknown":           1,
+		"class=gold,driver=s3.cosi.test,ready=false": 1,
+	}, values(families[BucketClaimsName]))
+	assert.Equal(t, map[string]float64{
+		"class=gold,driver=s3.cosi.test,ready=true": 2,
+		"class=,driver=s3.cosi.test,ready=unknown":  1,
+	}, values(families[BucketsName]))
+	assert.Equal(t, map[string]float64{
+		"class=rw,driver=s3.cosi.test,ready=false": 1,
+	}, values(families[BucketAccessesName]))
+	assert.Equal(t, map[string]float64{
+		"kind=BucketClaim":  1,
+		"kind=Bucket":       1,
+		"kind=BucketAccess": 0,
+	}, values(families[StuckDeletionsName]))
+}
+
+func TestLifecycleMetrics(t *testing.T) {
+	claim := &cosiapi.BucketClaim{
+		ObjectMeta: meta.ObjectMeta{
+			Namespace:         "ns",
+			Name:              "claim",
+			CreationTimestamp: meta.NewTime(time.Now().Add(-time.Minute)),
+		},
+	}
+	ObserveReady(KindBucketClaim, "lifecycle.cosi.test", claim)
+	ObserveReady(KindBucketClaim, "lifecycle.cosi.test", &cosiapi.BucketClaim{}) // no creation time: ignored
+	CountNonRetryableError(KindBucket, "lifecycle.cosi.test")
+	CountNonRetryableError(KindBucket, "lifecycle.cosi.test")
+
+	families := gather(t, ctrlmetrics.Registry)
+
+	var histogram *dto.Histogram
+	for _, m := range families[TimeToReadySecondsName].GetMetric() {
+		if labelString(m) == "driver=lifecycle.cosi.test,kind=BucketClaim" {
+			histogram = m.GetHistogram()
+		}
+	}
+	require.NotNil(t, histogram)
+	assert.Equal(t, uint64(1), histogram.GetSampleCount())
+	assert.InDelta(t, 60, histogram.GetSampleSum(), 5)
+
+	assert.Equal(t, float64(2),
+		values(families[NonRetryableErrorsName])["driver=lifecycle.cosi.test,kind=Bucket"])
+}
+
+func gather(t *testing.T, gatherer prometheus.Gatherer) map[string]*dto.MetricFamily {
+	t.Helper()
+	families, err := gatherer.Gather()
+	require.NoError(t, err)
+	out := map[string]*dto.MetricFamily{}
+	for _, f := range families {
+		out[f.GetName()] = f
+	}
+	return out
+}
+
+// map of sorted label pairs to gauge or counter value
+func values(family *dto.MetricFamily) map[string]float64 {
+	out := map[string]float64{}
+	for _, m := range family.GetMetric() {
+		v := m.GetGauge().GetValue()
+		if m.GetCounter() != nil {
+			v = m.GetCounter().GetValue()
+		}
+		out[labelString(m)] = v
+	}
+	return out
+}
+
+// label pairs are sorted by name when gathered
+func labelString(m *dto.Metric) string {
+	s := ""
+	for i, l := range m.GetLabel() {
+		if i > 0 {
+			s += ","
+		}
+		s += l.GetName() + "=" + l.GetValue()
+	}
+	return s
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -83,6 +84,19 @@ func GenerationChangedInUpdateOnly() predicate.Funcs {
 	return funcs
 }
 
+// LabelsChangedInUpdateOnly implements a predicate that enqueues a reconcile for Update events
+// where the resource's labels change.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func LabelsChangedInUpdateOnly() predicate.Funcs {
+	funcs := allFalseFuncs()
+	funcs.UpdateFunc = func(e event.UpdateEvent) bool {
+		return predicate.LabelChangedPredicate{}.Update(e)
+	}
+	return funcs
+}
+
 // ProtectionFinalizerRemoved implements a predicate that enqueues a reconcile for Update events
 // where the protection finalizer has been removed. This helps ensure that COSI always has a chance
 // to re-apply the protection finalizer when it's needed.
@@ -113,6 +127,95 @@ func ProtectionFinalizerRemoved(s *runtime.Scheme) predicate.Funcs {
 	return funcs
 }
 
+// AnnotationAdded implements a predicate that enqueues a reconcile for Update events where the
+// given annotation has been added to a resource. This allows users and administrators to request
+// actions from COSI by annotating resources.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func AnnotationAdded(key string) predicate.Funcs {
+	funcs := allFalseFuncs()
+	funcs.UpdateFunc = func(e event.UpdateEvent) bool {
+		_, oldHas := e.ObjectOld.GetAnnotations()[key]
+		_, newHas := e.ObjectNew.GetAnnotations()[key]
+		return !oldHas && newHas
+	}
+	return funcs
+}
+
+// BucketAccessReferencesRemoved implements a predicate that enqueues a reconcile for Update events
+// where the BucketAccess reference annotation has been removed from a deleting resource. This
+// allows BucketClaim deletion to continue promptly after the last referencing BucketAccess is gone.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func BucketAccessReferencesRemoved() predicate.Funcs {
+	funcs := allFalseFuncs()
+	funcs.UpdateFunc = func(e event.UpdateEvent) bool {
+		old := e.ObjectOld
+		new := e.ObjectNew
+
+		if new.GetDeletionTimestamp().IsZero() {
+			return false // only deletion is blocked by BucketAccess references
+		}
+
+		_, oldHas := old.GetAnnotations()[cosiapi.HasBucketAccessReferencesAnnotation]
+		_, newHas := new.GetAnnotations()[cosiapi.HasBucketAccessReferencesAnnotation]
+		return oldHas && !newHas
+	}
+	return funcs
+}
+
+// BucketStatusChanged implements a predicate that enqueues a reconcile for Update events where the
+// status of a Bucket changes. This allows resources that depend on Bucket provisioning results to
+// react as soon as they are available.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func BucketStatusChanged(s *runtime.Scheme) predicate.Funcs {
+	return statusChanged(s, func(b *cosiapi.Bucket) any { return b.Status })
+}
+
+// BucketClaimStatusChanged implements a predicate that enqueues a reconcile for Update events where
+// the status of a BucketClaim changes. This allows resources that depend on BucketClaim
+// provisioning results to react as soon as they are available.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func BucketClaimStatusChanged(s *runtime.Scheme) predicate.Funcs {
+	return statusChanged(s, func(c *cosiapi.BucketClaim) any { return c.Status })
+}
+
+// BucketQuotaStatusChanged implements a predicate that enqueues a reconcile for Update events where
+// the status of a BucketQuota changes. This allows resources that are waiting for quota to react as
+// soon as quota usage decreases.
+//
+// The predicate does not enqueue requests for any Create/Delete/Generic events.
+// This ensures that other predicates can effectively filter out undesired non-Update events.
+func BucketQuotaStatusChanged(s *runtime.Scheme) predicate.Funcs {
+	return statusChanged(s, func(q *cosiapi.BucketQuota) any { return q.Status })
+}
+
+// Internal logic for status change predicates.
+func statusChanged[T client.Object](s *runtime.Scheme, getStatus func(T) any) predicate.Funcs {
+	funcs := allFalseFuncs()
+	funcs.UpdateFunc = func(e event.UpdateEvent) bool {
+		logger := ctrl.Log.WithName("predicate")
+
+		old, ok := toTypedOrLogError[T](logger.WithValues("oldOrNew", "old"), s, e.ObjectOld)
+		if !ok {
+			return false // not the expected type, so don't manage it
+		}
+		new, ok := toTypedOrLogError[T](logger.WithValues("oldOrNew", "new"), s, e.ObjectNew)
+		if !ok {
+			return false // not the expected type, so don't manage it
+		}
+
+		return !equality.Semantic.DeepEqual(getStatus(old), getStatus(new))
+	}
+	return funcs
+}
+
 // BucketAccessHandoffOccurred implements a predicate that enqueues a BucketAccess reconcile for
 // Update events where the managing component of the BucketAccess changes, indicating that handoff
 // between Controller and Sidecar has occurred in either direction.
@@ -43,11 +43,7 @@ func ReconcileBucketClaim(
 
 	_, err := r.Reconcile(bootstrapped.ContextWithLogger, ctrl.Request{NamespacedName: nsName})
 	if err != nil {
-		if err.Error() != "waiting for Bucket to be provisioned" {
-			// to make tests consuming this easier, only return an error if it's not the one we
-			// (currently) expect after a successful reconcile
-			return nil, err
-		}
+		return nil, err
 	}
 
 	claim := &cosiapi.BucketClaim{}
@@ -110,8 +110,10 @@ type FakeProvisionerServer struct {
 
 	CreateBucketFunc       func(context.Context, *cosiproto.DriverCreateBucketRequest) (*cosiproto.DriverCreateBucketResponse, error)
 	GetExistingBucketFunc  func(context.Context, *cosiproto.DriverGetExistingBucketRequest) (*cosiproto.DriverGetExistingBucketResponse, error)
+	DeleteBucketFunc       func(context.Context, *cosiproto.DriverDeleteBucketRequest) (*cosiproto.DriverDeleteBucketResponse, error)
 	GrantBucketAccessFunc  func(context.Context, *cosiproto.DriverGrantBucketAccessRequest) (*cosiproto.DriverGrantBucketAccessResponse, error)
 	RevokeBucketAccessFunc func(context.Context, *cosiproto.DriverRevokeBucketAccessRequest) (*cosiproto.DriverRevokeBucketAccessResponse, error)
+	RotateCredentialsFunc  func(context.Context, *cosiproto.DriverRotateBucketAccessCredentialsRequest) (*cosiproto.DriverRotateBucketAccessCredentialsResponse, error)
 }
 
 func (s *FakeProvisionerServer) DriverCreateBucket(
@@ -134,6 +136,16 @@ func (s *FakeProvisionerServer) DriverGetExistingBucket(
 	panic("DriverGetExistingBucketFunc not implemented in FakeProvisionerServer")
 }
 
+func (s *FakeProvisionerServer) DriverDeleteBucket(
+	ctx context.Context, req *cosiproto.DriverDeleteBucketRequest,
+) (*cosiproto.DriverDeleteBucketResponse, error) {
+	if s.DeleteBucketFunc != nil {
+		return s.DeleteBucketFunc(ctx, req)
+	}
+	// unit tests must set an expectation if they expect the call to be made
+	panic("DriverDeleteBucketFunc not implemented in FakeProvisionerServer")
+}
+
 func (s *FakeProvisionerServer) DriverGrantBucketAccess(
 	ctx context.Context, req *cosiproto.DriverGrantBucketAccessRequest,
 ) (*cosiproto.DriverGrantBucketAccessResponse, error) {
@@ -153,3 +165,13 @@ func (s *FakeProvisionerServer) DriverRevokeBucketAccess(
 	// unit tests must set an expectation if they expect the call to be made
 	panic("DriverRevokeBucketAccessFunc not implemented in FakeProvisionerServer")
 }
+
+func (s *FakeProvisionerServer) DriverRotateBucketAccessCredentials(
+	ctx context.Context, req *cosiproto.DriverRotateBucketAccessCredentialsRequest,
+) (*cosiproto.DriverRotateBucketAccessCredentialsResponse, error) {
+	if s.RotateCredentialsFunc != nil {
+		return s.RotateCredentialsFunc(ctx, req)
+	}
+	// unit tests must set an expectation if they expect the call to be made
+	panic("DriverRotateBucketAccessCredentialsFunc not implemented in FakeProvisionerServer")
+}
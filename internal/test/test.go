@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	"sigs.k8s.io/container-object-storage-interface/internal/bucketaccess"
 )
 
 // Dependencies contains bootstrapped COSI unit test dependencies.
@@ -65,7 +66,9 @@ func mustNewClient(
 			&cosiapi.Bucket{},
 			&cosiapi.BucketClaim{},
 			&cosiapi.BucketAccess{},
+			&cosiapi.BucketQuota{},
 		).
+		WithIndex(&cosiapi.BucketAccess{}, bucketaccess.BucketClaimNameIndexKey, bucketaccess.IndexBucketClaimNames).
 		Build()
 
 	return client
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing configures optional OpenTelemetry tracing for the COSI Controller and Sidecars.
+//
+// Each reconcile is traced as a span. The COSI Controller records the trace context of the
+// reconcile that hands a Bucket or BucketAccess off to a COSI Sidecar in the resource's
+// TraceContextAnnotation, and the Sidecar continues that trace. The Sidecar passes the trace
+// context on to the driver in gRPC metadata.
+package tracing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// TracerName is the name of the tracer used for all COSI spans.
+const TracerName = "sigs.k8s.io/container-object-storage-interface"
+
+// W3C Trace Context header that holds the trace ID, parent span ID, and sampling decision.
+const traceparentKey = "traceparent"
+
+// Span attribute keys for reconciled resources.
+const (
+	ResourceKindKey = attribute.Key("cosi.resource.kind")
+	ResourceNameKey = attribute.Key("cosi.resource.name")
+)
+
+// Config configures tracing.
+type Config struct {
+	// Endpoint is the URL of an OTLP gRPC collector, e.g., http://otel-collector:4317. An http
+	// scheme connects without TLS. Tracing is disabled if empty.
+	Endpoint string
+
+	// SamplingRatio is the fraction of new traces that are sampled, from 0 to 1. Spans that
+	// continue a trace follow the sampling decision of the trace.
+	SamplingRatio float64
+}
+
+// BindFlags binds tracing configuration to command-line flags.
+func (c *Config) BindFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Endpoint, "tracing-endpoint", "",
+		"URL of an OTLP gRPC collector to export traces to, e.g., http://otel-collector:4317. "+
+			"Tracing is disabled if empty. Standard OTEL_EXPORTER_OTLP_* environment variables also apply.")
+	fs.Float64Var(&c.SamplingRatio, "tracing-sampling-ratio", 1,
+		"Fraction of new traces to sample, from 0 to 1.")
+}
+
+// Setup configures the global OpenTelemetry tracer provider and propagator to export traces for
+// the named service. The returned function flushes and stops exporting traces. If tracing is
+// disabled, Setup does nothing, and all spans are no-ops.
+func Setup(ctx context.Context, serviceName string, cfg Config) (shutdown func(context.Context) error, err error) {
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(cfg.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	// attributes from OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME take precedence
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer for COSI spans from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartReconcile starts a span for reconciling an object of the given kind. The caller must end
+// the span.
+//
+// If the object has a TraceContextAnnotation, the span continues the annotated trace while the
+// object is not yet ready to use, so that provisioning is traced end to end. After that, each
+// reconcile starts a new trace that links to the annotated trace instead of growing it forever.
+func StartReconcile(
+	ctx context.Context, kind string, obj client.Object, readyToUse *bool,
+) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(ResourceKindKey.String(kind), ResourceNameKey.String(obj.GetName())),
+	}
+	if ns := obj.GetNamespace(); ns != "" {
+		opts = append(opts, trace.WithAttributes(semconv.K8SNamespaceName(ns)))
+	}
+
+	if annotated := annotatedSpanContext(obj); annotated.IsValid() {
+		if readyToUse != nil && *readyToUse {
+			opts = append(opts, trace.WithNewRoot(), trace.WithLinks(trace.Link{SpanContext: annotated}))
+		} else {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, annotated)
+		}
+	}
+
+	return Tracer().Start(ctx, "Reconcile "+kind, opts...)
+}
+
+// RecordError marks the span as failed with the error. Nil errors are ignored.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// InjectAnnotation sets the object's TraceContextAnnotation to the trace context of the current
+// span if the annotation isn't already set. Returns true if the annotation was added. Nothing is
+// added if tracing is disabled.
+func InjectAnnotation(ctx context.Context, obj client.Object) bool {
+	if _, ok := obj.GetAnnotations()[cosiapi.TraceContextAnnotation]; ok {
+		return false
+	}
+
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	traceparent := carrier.Get(traceparentKey)
+	if traceparent == "" {
+		return false
+	}
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[cosiapi.TraceContextAnnotation] = traceparent
+	obj.SetAnnotations(annotations)
+	return true
+}
+
+// return the span context from the object's TraceContextAnnotation, which may be invalid
+func annotatedSpanContext(obj client.Object) trace.SpanContext {
+	traceparent, ok := obj.GetAnnotations()[cosiapi.TraceContextAnnotation]
+	if !ok {
+		return trace.SpanContext{}
+	}
+	carrier := propagation.MapCarrier{traceparentKey: traceparent}
+	// Use the W3C propagator regardless of the global one, which is a no-op if tracing is disabled.
+	return trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+}
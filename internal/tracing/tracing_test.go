@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// fakeCollector is a stand-in for an OTLP collector that records exported spans.
+type fakeCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	mu           sync.Mutex
+	spans        []*tracepb.Span
+	serviceNames []string
+}
+
+func (c *fakeCollector) Export(
+	_ context.Context, req *collectortrace.ExportTraceServiceRequest,
+) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, rs := range req.GetResourceSpans() {
+		for _, attr := range rs.GetResource().GetAttributes() {
+			if attr.GetKey() == "service.name" {
+				c.serviceNames = append(c.serviceNames, attr.GetValue().GetStringValue())
+			}
+		}
+		for _, ss := range rs.GetScopeSpans() {
+			c.spans = append(c.spans, ss.GetSpans()...)
+		}
+	}
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+func (c *fakeCollector) span(t *testing.T, spanID trace.SpanID) *tracepb.Span {
+	t.Helper()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, s := range c.spans {
+		if bytes.Equal(s.GetSpanId(), spanID[:]) {
+			return s
+		}
+	}
+	require.FailNow(t, "span not exported", "span ID %s", spanID)
+	return nil
+}
+
+// start a fake collector, and return its endpoint URL
+func startFakeCollector(t *testing.T) (*fakeCollector, string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	collector := &fakeCollector{}
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return collector, fmt.Sprintf("http://%s", listener.Addr())
+}
+
+// restore the default no-op global tracer provider and propagator after the test
+func resetGlobals(t *testing.T) {
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+}
+
+func TestSetup_disabled(t *testing.T) {
+	resetGlobals(t)
+
+	shutdown, err := Setup(context.Background(), "cosi-test", Config{})
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, shutdown(context.Background())) }()
+
+	ctx, span := StartReconcile(context.Background(), "BucketClaim", &cosiapi.BucketClaim{}, nil)
+	defer span.End()
+	assert.False(t, span.IsRecording())
+
+	bucket := &cosiapi.Bucket{}
+	assert.False(t, InjectAnnotation(ctx, bucket))
+	assert.Empty(t, bucket.Annotations)
+}
+
+func TestSetup_traceHandoff(t *testing.T) {
+	resetGlobals(t)
+	collector, endpoint := startFakeCollector(t)
+
+	shutdown, err := Setup(context.Background(), "cosi-test", Config{Endpoint: endpoint, SamplingRatio: 1})
+	require.NoError(t, err)
+
+	// Controller: reconcile a BucketClaim and create an annotated Bucket
+	claim := &cosiapi.BucketClaim{ObjectMeta: meta.ObjectMeta{Namespace: "ns", Name: "claim"}}
+	ctx, claimSpan := StartReconcile(context.Background(), "BucketClaim", claim, nil)
+	bucket := &cosiapi.Bucket{ObjectMeta: meta.ObjectMeta{Name: "bc-claim",
+		Annotations: map[string]string{"other": "value"}}}
+	require.True(t, InjectAnnotation(ctx, bucket))
+	assert.False(t, InjectAnnotation(ctx, bucket), "existing annotation must not be replaced")
+	assert.Equal(t, "value", bucket.Annotations["other"])
+	claimSpan.End()
+
+	// Sidecar: provisioning reconciles continue the trace
+	_, provisionSpan := StartReconcile(context.Background(), "Bucket", bucket, ptr.To(false))
+	RecordError(provisionSpan, fmt.Errorf("driver unavailable"))
+	provisionSpan.End()
+
+	// Sidecar: later reconciles start a new trace linked to the provisioning trace
+	_, laterSpan := StartReconcile(context.Background(), "Bucket", bucket, ptr.To(true))
+	RecordError(laterSpan, nil)
+	laterSpan.End()
+
+	require.NoError(t, shutdown(context.Background()))
+
+	claimSC := claimSpan.SpanContext()
+	claimExported := collector.span(t, claimSC.SpanID())
+	assert.Equal(t, "Reconcile BucketClaim", claimExported.GetName())
+	assert.Empty(t, claimExported.GetParentSpanId())
+
+	provisionExported := collector.span(t, provisionSpan.SpanContext().SpanID())
+	assert.Equal(t, "Reconcile Bucket", provisionExported.GetName())
+	assert.Equal(t, claimSC.TraceID(), provisionSpan.SpanContext().TraceID())
+	assert.Equal(t, claimSC.SpanID(), trace.SpanID(provisionExported.GetParentSpanId()))
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, provisionExported.GetStatus().GetCode())
+	assert.Equal(t, "driver unavailable", provisionExported.GetStatus().GetMessage())
+
+	laterExported := collector.span(t, laterSpan.SpanContext().SpanID())
+	assert.NotEqual(t, claimSC.TraceID(), laterSpan.SpanContext().TraceID())
+	assert.Empty(t, laterExported.GetParentSpanId())
+	require.Len(t, laterExported.GetLinks(), 1)
+	assert.Equal(t, claimSC.SpanID(), trace.SpanID(laterExported.GetLinks()[0].GetSpanId()))
+	assert.Equal(t, tracepb.Status_STATUS_CODE_UNSET, laterExported.GetStatus().GetCode())
+
+	attrs := map[string]string{}
+	for _, a := range claimExported.GetAttributes() {
+		attrs[a.GetKey()] = a.GetValue().GetStringValue()
+	}
+	assert.Equal(t, map[string]string{
+		string(ResourceKindKey): "BucketClaim",
+		string(ResourceNameKey): "claim",
+		"k8s.namespace.name":    "ns",
+	}, attrs)
+
+	assert.Contains(t, collector.serviceNames, "cosi-test")
+}
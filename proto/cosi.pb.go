@@ -76,7 +76,7 @@ func (x ObjectProtocol_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ObjectProtocol_Type.Descriptor instead.
 func (ObjectProtocol_Type) EnumDescriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{2, 0}
+	return file_cosi_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type S3AddressingStyle_Style int32
@@ -127,7 +127,7 @@ func (x S3AddressingStyle_Style) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use S3AddressingStyle_Style.Descriptor instead.
 func (S3AddressingStyle_Style) EnumDescriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{7, 0}
+	return file_cosi_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type AuthenticationType_Type int32
@@ -180,7 +180,7 @@ func (x AuthenticationType_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthenticationType_Type.Descriptor instead.
 func (AuthenticationType_Type) EnumDescriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{12, 0}
+	return file_cosi_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type AccessMode_Mode int32
@@ -235,7 +235,7 @@ func (x AccessMode_Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccessMode_Mode.Descriptor instead.
 func (AccessMode_Mode) EnumDescriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{13, 0}
+	return file_cosi_proto_rawDescGZIP(), []int{16, 0}
 }
 
 type DriverGetInfoRequest struct {
@@ -288,8 +288,12 @@ type DriverGetInfoResponse struct {
 	// A list of all object storage protocols supported by the driver.
 	// At least one protocol is REQUIRED.
 	SupportedProtocols []*ObjectProtocol `protobuf:"bytes,2,rep,name=supported_protocols,json=supportedProtocols,proto3" json:"supported_protocols,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// OPTIONAL. Optional features supported by the driver.
+	// If unset, COSI assumes that the driver supports all optional features, and COSI relies on
+	// RPC errors to determine when a feature is unsupported.
+	Capabilities  *DriverCapabilities `protobuf:"bytes,3,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DriverGetInfoResponse) Reset() {
@@ -336,6 +340,194 @@ func (x *DriverGetInfoResponse) GetSupportedProtocols() []*ObjectProtocol {
 	return nil
 }
 
+func (x *DriverGetInfoResponse) GetCapabilities() *DriverCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type DriverCapabilities struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The driver supports granting a single access to multiple buckets.
+	// If false, COSI WILL NOT call `DriverGrantBucketAccess` with more than one bucket.
+	MultiBucketAccess bool `protobuf:"varint,1,opt,name=multi_bucket_access,json=multiBucketAccess,proto3" json:"multi_bucket_access,omitempty"`
+	// The driver supports deleting backend buckets.
+	// If false, COSI WILL NOT call `DriverDeleteBucket`, and buckets with the `Delete` deletion
+	// policy are rejected.
+	BucketDeletion bool `protobuf:"varint,2,opt,name=bucket_deletion,json=bucketDeletion,proto3" json:"bucket_deletion,omitempty"`
+	// The driver supports statically-provisioned buckets.
+	// If false, COSI WILL NOT call `DriverGetExistingBucket`.
+	StaticProvisioning bool `protobuf:"varint,3,opt,name=static_provisioning,json=staticProvisioning,proto3" json:"static_provisioning,omitempty"`
+	// The driver supports the `SERVICE_ACCOUNT` authentication type.
+	// If false, COSI WILL NOT request the `SERVICE_ACCOUNT` authentication type.
+	ServiceAccountAuthentication bool `protobuf:"varint,4,opt,name=service_account_authentication,json=serviceAccountAuthentication,proto3" json:"service_account_authentication,omitempty"`
+	// The driver supports rotating access credentials.
+	// If false, COSI WILL NOT call `DriverRotateBucketAccessCredentials`.
+	CredentialRotation bool `protobuf:"varint,5,opt,name=credential_rotation,json=credentialRotation,proto3" json:"credential_rotation,omitempty"`
+	// A list of the bucket access modes supported by the driver.
+	// If empty, COSI assumes that the driver supports all access modes.
+	// Otherwise, COSI WILL NOT request access modes that are not in the list.
+	SupportedAccessModes []*AccessMode `protobuf:"bytes,6,rep,name=supported_access_modes,json=supportedAccessModes,proto3" json:"supported_access_modes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DriverCapabilities) Reset() {
+	*x = DriverCapabilities{}
+	mi := &file_cosi_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverCapabilities) ProtoMessage() {}
+
+func (x *DriverCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverCapabilities.ProtoReflect.Descriptor instead.
+func (*DriverCapabilities) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DriverCapabilities) GetMultiBucketAccess() bool {
+	if x != nil {
+		return x.MultiBucketAccess
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetBucketDeletion() bool {
+	if x != nil {
+		return x.BucketDeletion
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetStaticProvisioning() bool {
+	if x != nil {
+		return x.StaticProvisioning
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetServiceAccountAuthentication() bool {
+	if x != nil {
+		return x.ServiceAccountAuthentication
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetCredentialRotation() bool {
+	if x != nil {
+		return x.CredentialRotation
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetSupportedAccessModes() []*AccessMode {
+	if x != nil {
+		return x.SupportedAccessModes
+	}
+	return nil
+}
+
+type DriverProbeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverProbeRequest) Reset() {
+	*x = DriverProbeRequest{}
+	mi := &file_cosi_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverProbeRequest) ProtoMessage() {}
+
+func (x *DriverProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverProbeRequest.ProtoReflect.Descriptor instead.
+func (*DriverProbeRequest) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{3}
+}
+
+type DriverProbeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// REQUIRED. Whether the driver is ready to serve Provisioner RPCs.
+	// A driver that is healthy but still initializing (e.g., waiting for the backend to become
+	// reachable) MUST return `false`.
+	Ready         bool `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverProbeResponse) Reset() {
+	*x = DriverProbeResponse{}
+	mi := &file_cosi_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverProbeResponse) ProtoMessage() {}
+
+func (x *DriverProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverProbeResponse.ProtoReflect.Descriptor instead.
+func (*DriverProbeResponse) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DriverProbeResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
 type ObjectProtocol struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          ObjectProtocol_Type    `protobuf:"varint,1,opt,name=type,proto3,enum=sigs.k8s.io.cosi.v1alpha2.ObjectProtocol_Type" json:"type,omitempty"`
@@ -345,7 +537,7 @@ type ObjectProtocol struct {
 
 func (x *ObjectProtocol) Reset() {
 	*x = ObjectProtocol{}
-	mi := &file_cosi_proto_msgTypes[2]
+	mi := &file_cosi_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +549,7 @@ func (x *ObjectProtocol) String() string {
 func (*ObjectProtocol) ProtoMessage() {}
 
 func (x *ObjectProtocol) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[2]
+	mi := &file_cosi_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +562,7 @@ func (x *ObjectProtocol) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectProtocol.ProtoReflect.Descriptor instead.
 func (*ObjectProtocol) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{2}
+	return file_cosi_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ObjectProtocol) GetType() ObjectProtocol_Type {
@@ -396,7 +588,7 @@ type ObjectProtocolAndBucketInfo struct {
 
 func (x *ObjectProtocolAndBucketInfo) Reset() {
 	*x = ObjectProtocolAndBucketInfo{}
-	mi := &file_cosi_proto_msgTypes[3]
+	mi := &file_cosi_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -408,7 +600,7 @@ func (x *ObjectProtocolAndBucketInfo) String() string {
 func (*ObjectProtocolAndBucketInfo) ProtoMessage() {}
 
 func (x *ObjectProtocolAndBucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[3]
+	mi := &file_cosi_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -421,7 +613,7 @@ func (x *ObjectProtocolAndBucketInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectProtocolAndBucketInfo.ProtoReflect.Descriptor instead.
 func (*ObjectProtocolAndBucketInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{3}
+	return file_cosi_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ObjectProtocolAndBucketInfo) GetS3() *S3BucketInfo {
@@ -461,7 +653,7 @@ type CredentialInfo struct {
 
 func (x *CredentialInfo) Reset() {
 	*x = CredentialInfo{}
-	mi := &file_cosi_proto_msgTypes[4]
+	mi := &file_cosi_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -473,7 +665,7 @@ func (x *CredentialInfo) String() string {
 func (*CredentialInfo) ProtoMessage() {}
 
 func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[4]
+	mi := &file_cosi_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,7 +678,7 @@ func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CredentialInfo.ProtoReflect.Descriptor instead.
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{4}
+	return file_cosi_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CredentialInfo) GetS3() *S3CredentialInfo {
@@ -528,7 +720,7 @@ type S3BucketInfo struct {
 
 func (x *S3BucketInfo) Reset() {
 	*x = S3BucketInfo{}
-	mi := &file_cosi_proto_msgTypes[5]
+	mi := &file_cosi_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +732,7 @@ func (x *S3BucketInfo) String() string {
 func (*S3BucketInfo) ProtoMessage() {}
 
 func (x *S3BucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[5]
+	mi := &file_cosi_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +745,7 @@ func (x *S3BucketInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S3BucketInfo.ProtoReflect.Descriptor instead.
 func (*S3BucketInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{5}
+	return file_cosi_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *S3BucketInfo) GetBucketId() string {
@@ -596,7 +788,7 @@ type S3CredentialInfo struct {
 
 func (x *S3CredentialInfo) Reset() {
 	*x = S3CredentialInfo{}
-	mi := &file_cosi_proto_msgTypes[6]
+	mi := &file_cosi_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +800,7 @@ func (x *S3CredentialInfo) String() string {
 func (*S3CredentialInfo) ProtoMessage() {}
 
 func (x *S3CredentialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[6]
+	mi := &file_cosi_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +813,7 @@ func (x *S3CredentialInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S3CredentialInfo.ProtoReflect.Descriptor instead.
 func (*S3CredentialInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{6}
+	return file_cosi_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *S3CredentialInfo) GetAccessKeyId() string {
@@ -649,7 +841,7 @@ type S3AddressingStyle struct {
 
 func (x *S3AddressingStyle) Reset() {
 	*x = S3AddressingStyle{}
-	mi := &file_cosi_proto_msgTypes[7]
+	mi := &file_cosi_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -661,7 +853,7 @@ func (x *S3AddressingStyle) String() string {
 func (*S3AddressingStyle) ProtoMessage() {}
 
 func (x *S3AddressingStyle) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[7]
+	mi := &file_cosi_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +866,7 @@ func (x *S3AddressingStyle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use S3AddressingStyle.ProtoReflect.Descriptor instead.
 func (*S3AddressingStyle) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{7}
+	return file_cosi_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *S3AddressingStyle) GetStyle() S3AddressingStyle_Style {
@@ -694,7 +886,7 @@ type AzureBucketInfo struct {
 
 func (x *AzureBucketInfo) Reset() {
 	*x = AzureBucketInfo{}
-	mi := &file_cosi_proto_msgTypes[8]
+	mi := &file_cosi_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -706,7 +898,7 @@ func (x *AzureBucketInfo) String() string {
 func (*AzureBucketInfo) ProtoMessage() {}
 
 func (x *AzureBucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[8]
+	mi := &file_cosi_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -719,7 +911,7 @@ func (x *AzureBucketInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AzureBucketInfo.ProtoReflect.Descriptor instead.
 func (*AzureBucketInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{8}
+	return file_cosi_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AzureBucketInfo) GetStorageAccount() string {
@@ -744,7 +936,7 @@ type AzureCredentialInfo struct {
 
 func (x *AzureCredentialInfo) Reset() {
 	*x = AzureCredentialInfo{}
-	mi := &file_cosi_proto_msgTypes[9]
+	mi := &file_cosi_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -756,7 +948,7 @@ func (x *AzureCredentialInfo) String() string {
 func (*AzureCredentialInfo) ProtoMessage() {}
 
 func (x *AzureCredentialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[9]
+	mi := &file_cosi_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,7 +961,7 @@ func (x *AzureCredentialInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AzureCredentialInfo.ProtoReflect.Descriptor instead.
 func (*AzureCredentialInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{9}
+	return file_cosi_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AzureCredentialInfo) GetAccessToken() string {
@@ -798,7 +990,7 @@ type GcsBucketInfo struct {
 
 func (x *GcsBucketInfo) Reset() {
 	*x = GcsBucketInfo{}
-	mi := &file_cosi_proto_msgTypes[10]
+	mi := &file_cosi_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -810,7 +1002,7 @@ func (x *GcsBucketInfo) String() string {
 func (*GcsBucketInfo) ProtoMessage() {}
 
 func (x *GcsBucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[10]
+	mi := &file_cosi_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,7 +1015,7 @@ func (x *GcsBucketInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GcsBucketInfo.ProtoReflect.Descriptor instead.
 func (*GcsBucketInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{10}
+	return file_cosi_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GcsBucketInfo) GetProjectId() string {
@@ -856,7 +1048,7 @@ type GcsCredentialInfo struct {
 
 func (x *GcsCredentialInfo) Reset() {
 	*x = GcsCredentialInfo{}
-	mi := &file_cosi_proto_msgTypes[11]
+	mi := &file_cosi_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -868,7 +1060,7 @@ func (x *GcsCredentialInfo) String() string {
 func (*GcsCredentialInfo) ProtoMessage() {}
 
 func (x *GcsCredentialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[11]
+	mi := &file_cosi_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1073,7 @@ func (x *GcsCredentialInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GcsCredentialInfo.ProtoReflect.Descriptor instead.
 func (*GcsCredentialInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{11}
+	return file_cosi_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GcsCredentialInfo) GetAccessId() string {
@@ -921,7 +1113,7 @@ type AuthenticationType struct {
 
 func (x *AuthenticationType) Reset() {
 	*x = AuthenticationType{}
-	mi := &file_cosi_proto_msgTypes[12]
+	mi := &file_cosi_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -933,7 +1125,7 @@ func (x *AuthenticationType) String() string {
 func (*AuthenticationType) ProtoMessage() {}
 
 func (x *AuthenticationType) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[12]
+	mi := &file_cosi_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -946,7 +1138,7 @@ func (x *AuthenticationType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuthenticationType.ProtoReflect.Descriptor instead.
 func (*AuthenticationType) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{12}
+	return file_cosi_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AuthenticationType) GetType() AuthenticationType_Type {
@@ -965,7 +1157,7 @@ type AccessMode struct {
 
 func (x *AccessMode) Reset() {
 	*x = AccessMode{}
-	mi := &file_cosi_proto_msgTypes[13]
+	mi := &file_cosi_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -977,7 +1169,7 @@ func (x *AccessMode) String() string {
 func (*AccessMode) ProtoMessage() {}
 
 func (x *AccessMode) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[13]
+	mi := &file_cosi_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -990,7 +1182,7 @@ func (x *AccessMode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccessMode.ProtoReflect.Descriptor instead.
 func (*AccessMode) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{13}
+	return file_cosi_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AccessMode) GetMode() AccessMode_Mode {
@@ -1033,7 +1225,7 @@ type DriverCreateBucketRequest struct {
 
 func (x *DriverCreateBucketRequest) Reset() {
 	*x = DriverCreateBucketRequest{}
-	mi := &file_cosi_proto_msgTypes[14]
+	mi := &file_cosi_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1237,7 @@ func (x *DriverCreateBucketRequest) String() string {
 func (*DriverCreateBucketRequest) ProtoMessage() {}
 
 func (x *DriverCreateBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[14]
+	mi := &file_cosi_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1250,7 @@ func (x *DriverCreateBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverCreateBucketRequest.ProtoReflect.Descriptor instead.
 func (*DriverCreateBucketRequest) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{14}
+	return file_cosi_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DriverCreateBucketRequest) GetName() string {
@@ -1114,7 +1306,7 @@ type DriverCreateBucketResponse struct {
 
 func (x *DriverCreateBucketResponse) Reset() {
 	*x = DriverCreateBucketResponse{}
-	mi := &file_cosi_proto_msgTypes[15]
+	mi := &file_cosi_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1126,7 +1318,7 @@ func (x *DriverCreateBucketResponse) String() string {
 func (*DriverCreateBucketResponse) ProtoMessage() {}
 
 func (x *DriverCreateBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[15]
+	mi := &file_cosi_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1139,7 +1331,7 @@ func (x *DriverCreateBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverCreateBucketResponse.ProtoReflect.Descriptor instead.
 func (*DriverCreateBucketResponse) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{15}
+	return file_cosi_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DriverCreateBucketResponse) GetBucketId() string {
@@ -1178,7 +1370,7 @@ type DriverGetExistingBucketRequest struct {
 
 func (x *DriverGetExistingBucketRequest) Reset() {
 	*x = DriverGetExistingBucketRequest{}
-	mi := &file_cosi_proto_msgTypes[16]
+	mi := &file_cosi_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1382,7 @@ func (x *DriverGetExistingBucketRequest) String() string {
 func (*DriverGetExistingBucketRequest) ProtoMessage() {}
 
 func (x *DriverGetExistingBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[16]
+	mi := &file_cosi_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1395,7 @@ func (x *DriverGetExistingBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverGetExistingBucketRequest.ProtoReflect.Descriptor instead.
 func (*DriverGetExistingBucketRequest) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{16}
+	return file_cosi_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DriverGetExistingBucketRequest) GetExistingBucketId() string {
@@ -1259,7 +1451,7 @@ type DriverGetExistingBucketResponse struct {
 
 func (x *DriverGetExistingBucketResponse) Reset() {
 	*x = DriverGetExistingBucketResponse{}
-	mi := &file_cosi_proto_msgTypes[17]
+	mi := &file_cosi_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1271,7 +1463,7 @@ func (x *DriverGetExistingBucketResponse) String() string {
 func (*DriverGetExistingBucketResponse) ProtoMessage() {}
 
 func (x *DriverGetExistingBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[17]
+	mi := &file_cosi_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1476,7 @@ func (x *DriverGetExistingBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverGetExistingBucketResponse.ProtoReflect.Descriptor instead.
 func (*DriverGetExistingBucketResponse) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{17}
+	return file_cosi_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DriverGetExistingBucketResponse) GetBucketId() string {
@@ -1315,7 +1507,7 @@ type DriverDeleteBucketRequest struct {
 
 func (x *DriverDeleteBucketRequest) Reset() {
 	*x = DriverDeleteBucketRequest{}
-	mi := &file_cosi_proto_msgTypes[18]
+	mi := &file_cosi_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1327,7 +1519,7 @@ func (x *DriverDeleteBucketRequest) String() string {
 func (*DriverDeleteBucketRequest) ProtoMessage() {}
 
 func (x *DriverDeleteBucketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[18]
+	mi := &file_cosi_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1532,7 @@ func (x *DriverDeleteBucketRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverDeleteBucketRequest.ProtoReflect.Descriptor instead.
 func (*DriverDeleteBucketRequest) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{18}
+	return file_cosi_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *DriverDeleteBucketRequest) GetBucketId() string {
@@ -1365,7 +1557,7 @@ type DriverDeleteBucketResponse struct {
 
 func (x *DriverDeleteBucketResponse) Reset() {
 	*x = DriverDeleteBucketResponse{}
-	mi := &file_cosi_proto_msgTypes[19]
+	mi := &file_cosi_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1377,7 +1569,7 @@ func (x *DriverDeleteBucketResponse) String() string {
 func (*DriverDeleteBucketResponse) ProtoMessage() {}
 
 func (x *DriverDeleteBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[19]
+	mi := &file_cosi_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,7 +1582,7 @@ func (x *DriverDeleteBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverDeleteBucketResponse.ProtoReflect.Descriptor instead.
 func (*DriverDeleteBucketResponse) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{19}
+	return file_cosi_proto_rawDescGZIP(), []int{22}
 }
 
 type DriverGrantBucketAccessRequest struct {
@@ -1432,7 +1624,7 @@ type DriverGrantBucketAccessRequest struct {
 
 func (x *DriverGrantBucketAccessRequest) Reset() {
 	*x = DriverGrantBucketAccessRequest{}
-	mi := &file_cosi_proto_msgTypes[20]
+	mi := &file_cosi_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +1636,7 @@ func (x *DriverGrantBucketAccessRequest) String() string {
 func (*DriverGrantBucketAccessRequest) ProtoMessage() {}
 
 func (x *DriverGrantBucketAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[20]
+	mi := &file_cosi_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1649,7 @@ func (x *DriverGrantBucketAccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverGrantBucketAccessRequest.ProtoReflect.Descriptor instead.
 func (*DriverGrantBucketAccessRequest) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{20}
+	return file_cosi_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DriverGrantBucketAccessRequest) GetAccountName() string {
@@ -1521,7 +1713,7 @@ type DriverGrantBucketAccessResponse struct {
 
 func (x *DriverGrantBucketAccessResponse) Reset() {
 	*x = DriverGrantBucketAccessResponse{}
-	mi := &file_cosi_proto_msgTypes[21]
+	mi := &file_cosi_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +1725,7 @@ func (x *DriverGrantBucketAccessResponse) String() string {
 func (*DriverGrantBucketAccessResponse) ProtoMessage() {}
 
 func (x *DriverGrantBucketAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[21]
+	mi := &file_cosi_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +1738,7 @@ func (x *DriverGrantBucketAccessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverGrantBucketAccessResponse.ProtoReflect.Descriptor instead.
 func (*DriverGrantBucketAccessResponse) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{21}
+	return file_cosi_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DriverGrantBucketAccessResponse) GetAccountId() string {
@@ -1594,7 +1786,7 @@ type DriverRevokeBucketAccessRequest struct {
 
 func (x *DriverRevokeBucketAccessRequest) Reset() {
 	*x = DriverRevokeBucketAccessRequest{}
-	mi := &file_cosi_proto_msgTypes[22]
+	mi := &file_cosi_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1798,7 @@ func (x *DriverRevokeBucketAccessRequest) String() string {
 func (*DriverRevokeBucketAccessRequest) ProtoMessage() {}
 
 func (x *DriverRevokeBucketAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[22]
+	mi := &file_cosi_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1811,7 @@ func (x *DriverRevokeBucketAccessRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverRevokeBucketAccessRequest.ProtoReflect.Descriptor instead.
 func (*DriverRevokeBucketAccessRequest) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{22}
+	return file_cosi_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DriverRevokeBucketAccessRequest) GetAccountId() string {
@@ -1672,7 +1864,7 @@ type DriverRevokeBucketAccessResponse struct {
 
 func (x *DriverRevokeBucketAccessResponse) Reset() {
 	*x = DriverRevokeBucketAccessResponse{}
-	mi := &file_cosi_proto_msgTypes[23]
+	mi := &file_cosi_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1684,7 +1876,7 @@ func (x *DriverRevokeBucketAccessResponse) String() string {
 func (*DriverRevokeBucketAccessResponse) ProtoMessage() {}
 
 func (x *DriverRevokeBucketAccessResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[23]
+	mi := &file_cosi_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,7 +1889,137 @@ func (x *DriverRevokeBucketAccessResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverRevokeBucketAccessResponse.ProtoReflect.Descriptor instead.
 func (*DriverRevokeBucketAccessResponse) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{23}
+	return file_cosi_proto_rawDescGZIP(), []int{26}
+}
+
+type DriverRotateBucketAccessCredentialsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// REQUIRED. The unique identifier for the backend access account.
+	// To prevent abuse, this must be at most 2048 characters long, consisting of alphanumeric
+	// characters ([a-z0-9A-Z]), dashes (-), and dots (.).
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// REQUIRED. The object storage protocol associated with the provisioned access.
+	Protocol *ObjectProtocol `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// REQUIRED. The authentication type associated with the provisioned access.
+	AuthenticationType *AuthenticationType `protobuf:"bytes,3,opt,name=authentication_type,json=authenticationType,proto3" json:"authentication_type,omitempty"`
+	// OPTIONAL. Plugin specific parameters associated with the provisioned access.
+	Parameters map[string]string `protobuf:"bytes,4,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// REQUIRED. Buckets associated with the provisioned access.
+	Buckets       []*DriverRotateBucketAccessCredentialsRequest_AccessedBucket `protobuf:"bytes,5,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) Reset() {
+	*x = DriverRotateBucketAccessCredentialsRequest{}
+	mi := &file_cosi_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverRotateBucketAccessCredentialsRequest) ProtoMessage() {}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverRotateBucketAccessCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*DriverRotateBucketAccessCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) GetProtocol() *ObjectProtocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return nil
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) GetAuthenticationType() *AuthenticationType {
+	if x != nil {
+		return x.AuthenticationType
+	}
+	return nil
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest) GetBuckets() []*DriverRotateBucketAccessCredentialsRequest_AccessedBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type DriverRotateBucketAccessCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// REQUIRED. The new credentials for the access.
+	// COSI WILL treat this information as sensitive/secret information.
+	// COSI WILL not log the information or store it in plaintext.
+	Credentials   *CredentialInfo `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverRotateBucketAccessCredentialsResponse) Reset() {
+	*x = DriverRotateBucketAccessCredentialsResponse{}
+	mi := &file_cosi_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverRotateBucketAccessCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverRotateBucketAccessCredentialsResponse) ProtoMessage() {}
+
+func (x *DriverRotateBucketAccessCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverRotateBucketAccessCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*DriverRotateBucketAccessCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DriverRotateBucketAccessCredentialsResponse) GetCredentials() *CredentialInfo {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
 }
 
 type DriverGrantBucketAccessRequest_AccessedBucket struct {
@@ -1715,7 +2037,7 @@ type DriverGrantBucketAccessRequest_AccessedBucket struct {
 
 func (x *DriverGrantBucketAccessRequest_AccessedBucket) Reset() {
 	*x = DriverGrantBucketAccessRequest_AccessedBucket{}
-	mi := &file_cosi_proto_msgTypes[28]
+	mi := &file_cosi_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +2049,7 @@ func (x *DriverGrantBucketAccessRequest_AccessedBucket) String() string {
 func (*DriverGrantBucketAccessRequest_AccessedBucket) ProtoMessage() {}
 
 func (x *DriverGrantBucketAccessRequest_AccessedBucket) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[28]
+	mi := &file_cosi_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +2062,7 @@ func (x *DriverGrantBucketAccessRequest_AccessedBucket) ProtoReflect() protorefl
 
 // Deprecated: Use DriverGrantBucketAccessRequest_AccessedBucket.ProtoReflect.Descriptor instead.
 func (*DriverGrantBucketAccessRequest_AccessedBucket) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{20, 1}
+	return file_cosi_proto_rawDescGZIP(), []int{23, 1}
 }
 
 func (x *DriverGrantBucketAccessRequest_AccessedBucket) GetBucketId() string {
@@ -1777,7 +2099,7 @@ type DriverGrantBucketAccessResponse_BucketInfo struct {
 
 func (x *DriverGrantBucketAccessResponse_BucketInfo) Reset() {
 	*x = DriverGrantBucketAccessResponse_BucketInfo{}
-	mi := &file_cosi_proto_msgTypes[29]
+	mi := &file_cosi_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1789,7 +2111,7 @@ func (x *DriverGrantBucketAccessResponse_BucketInfo) String() string {
 func (*DriverGrantBucketAccessResponse_BucketInfo) ProtoMessage() {}
 
 func (x *DriverGrantBucketAccessResponse_BucketInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[29]
+	mi := &file_cosi_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1802,7 +2124,7 @@ func (x *DriverGrantBucketAccessResponse_BucketInfo) ProtoReflect() protoreflect
 
 // Deprecated: Use DriverGrantBucketAccessResponse_BucketInfo.ProtoReflect.Descriptor instead.
 func (*DriverGrantBucketAccessResponse_BucketInfo) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{21, 0}
+	return file_cosi_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *DriverGrantBucketAccessResponse_BucketInfo) GetBucketId() string {
@@ -1829,7 +2151,7 @@ type DriverRevokeBucketAccessRequest_AccessedBucket struct {
 
 func (x *DriverRevokeBucketAccessRequest_AccessedBucket) Reset() {
 	*x = DriverRevokeBucketAccessRequest_AccessedBucket{}
-	mi := &file_cosi_proto_msgTypes[31]
+	mi := &file_cosi_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1841,7 +2163,7 @@ func (x *DriverRevokeBucketAccessRequest_AccessedBucket) String() string {
 func (*DriverRevokeBucketAccessRequest_AccessedBucket) ProtoMessage() {}
 
 func (x *DriverRevokeBucketAccessRequest_AccessedBucket) ProtoReflect() protoreflect.Message {
-	mi := &file_cosi_proto_msgTypes[31]
+	mi := &file_cosi_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1854,7 +2176,7 @@ func (x *DriverRevokeBucketAccessRequest_AccessedBucket) ProtoReflect() protoref
 
 // Deprecated: Use DriverRevokeBucketAccessRequest_AccessedBucket.ProtoReflect.Descriptor instead.
 func (*DriverRevokeBucketAccessRequest_AccessedBucket) Descriptor() ([]byte, []int) {
-	return file_cosi_proto_rawDescGZIP(), []int{22, 1}
+	return file_cosi_proto_rawDescGZIP(), []int{25, 1}
 }
 
 func (x *DriverRevokeBucketAccessRequest_AccessedBucket) GetBucketId() string {
@@ -1864,6 +2186,61 @@ func (x *DriverRevokeBucketAccessRequest_AccessedBucket) GetBucketId() string {
 	return ""
 }
 
+type DriverRotateBucketAccessCredentialsRequest_AccessedBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// REQUIRED. The unique identifier for the backend bucket known to the Provisioner.
+	BucketId string `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	// REQUIRED. The read/write access mode provisioned for the bucket associated with
+	// `bucket_id`.
+	AccessMode    *AccessMode `protobuf:"bytes,2,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest_AccessedBucket) Reset() {
+	*x = DriverRotateBucketAccessCredentialsRequest_AccessedBucket{}
+	mi := &file_cosi_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest_AccessedBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverRotateBucketAccessCredentialsRequest_AccessedBucket) ProtoMessage() {}
+
+func (x *DriverRotateBucketAccessCredentialsRequest_AccessedBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_cosi_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverRotateBucketAccessCredentialsRequest_AccessedBucket.ProtoReflect.Descriptor instead.
+func (*DriverRotateBucketAccessCredentialsRequest_AccessedBucket) Descriptor() ([]byte, []int) {
+	return file_cosi_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest_AccessedBucket) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *DriverRotateBucketAccessCredentialsRequest_AccessedBucket) GetAccessMode() *AccessMode {
+	if x != nil {
+		return x.AccessMode
+	}
+	return nil
+}
+
 var file_cosi_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.EnumOptions)(nil),
@@ -1994,10 +2371,21 @@ const file_cosi_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
 	"cosi.proto\x12\x19sigs.k8s.io.cosi.v1alpha2\x1a google/protobuf/descriptor.proto\"\x16\n" +
-	"\x14DriverGetInfoRequest\"\x87\x01\n" +
+	"\x14DriverGetInfoRequest\"\xda\x01\n" +
 	"\x15DriverGetInfoResponse\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12Z\n" +
-	"\x13supported_protocols\x18\x02 \x03(\v2).sigs.k8s.io.cosi.v1alpha2.ObjectProtocolR\x12supportedProtocols\"\x85\x01\n" +
+	"\x13supported_protocols\x18\x02 \x03(\v2).sigs.k8s.io.cosi.v1alpha2.ObjectProtocolR\x12supportedProtocols\x12Q\n" +
+	"\fcapabilities\x18\x03 \x01(\v2-.sigs.k8s.io.cosi.v1alpha2.DriverCapabilitiesR\fcapabilities\"\xf2\x02\n" +
+	"\x12DriverCapabilities\x12.\n" +
+	"\x13multi_bucket_access\x18\x01 \x01(\bR\x11multiBucketAccess\x12'\n" +
+	"\x0fbucket_deletion\x18\x02 \x01(\bR\x0ebucketDeletion\x12/\n" +
+	"\x13static_provisioning\x18\x03 \x01(\bR\x12staticProvisioning\x12D\n" +
+	"\x1eservice_account_authentication\x18\x04 \x01(\bR\x1cserviceAccountAuthentication\x12/\n" +
+	"\x13credential_rotation\x18\x05 \x01(\bR\x12credentialRotation\x12[\n" +
+	"\x16supported_access_modes\x18\x06 \x03(\v2%.sigs.k8s.io.cosi.v1alpha2.AccessModeR\x14supportedAccessModes\"\x14\n" +
+	"\x12DriverProbeRequest\"+\n" +
+	"\x13DriverProbeResponse\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready\"\x85\x01\n" +
 	"\x0eObjectProtocol\x12B\n" +
 	"\x04type\x18\x01 \x01(\x0e2..sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.TypeR\x04type\"/\n" +
 	"\x04Type\x12\v\n" +
@@ -2008,11 +2396,11 @@ const file_cosi_proto_rawDesc = "" +
 	"\x1bObjectProtocolAndBucketInfo\x127\n" +
 	"\x02s3\x18\x01 \x01(\v2'.sigs.k8s.io.cosi.v1alpha2.S3BucketInfoR\x02s3\x12@\n" +
 	"\x05azure\x18\x02 \x01(\v2*.sigs.k8s.io.cosi.v1alpha2.AzureBucketInfoR\x05azure\x12:\n" +
-	"\x03gcs\x18\x03 \x01(\v2(.sigs.k8s.io.cosi.v1alpha2.GcsBucketInfoR\x03gcs\"\xd3\x01\n" +
-	"\x0eCredentialInfo\x12;\n" +
-	"\x02s3\x18\x01 \x01(\v2+.sigs.k8s.io.cosi.v1alpha2.S3CredentialInfoR\x02s3\x12D\n" +
-	"\x05azure\x18\x02 \x01(\v2..sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfoR\x05azure\x12>\n" +
-	"\x03gcs\x18\x03 \x01(\v2,.sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfoR\x03gcs\"\xb8\x01\n" +
+	"\x03gcs\x18\x03 \x01(\v2(.sigs.k8s.io.cosi.v1alpha2.GcsBucketInfoR\x03gcs\"\xe2\x01\n" +
+	"\x0eCredentialInfo\x12@\n" +
+	"\x02s3\x18\x01 \x01(\v2+.sigs.k8s.io.cosi.v1alpha2.S3CredentialInfoB\x03\xd8E\x01R\x02s3\x12I\n" +
+	"\x05azure\x18\x02 \x01(\v2..sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfoB\x03\xd8E\x01R\x05azure\x12C\n" +
+	"\x03gcs\x18\x03 \x01(\v2,.sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfoB\x03\xd8E\x01R\x03gcs\"\xb8\x01\n" +
 	"\fS3BucketInfo\x12\x1b\n" +
 	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x16\n" +
@@ -2106,16 +2494,16 @@ const file_cosi_proto_rawDesc = "" +
 	"\x0eAccessedBucket\x12\x1b\n" +
 	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\x12F\n" +
 	"\vaccess_mode\x18\x02 \x01(\v2%.sigs.k8s.io.cosi.v1alpha2.AccessModeR\n" +
-	"accessMode\"\xf3\x02\n" +
+	"accessMode\"\xf8\x02\n" +
 	"\x1fDriverGrantBucketAccessResponse\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12_\n" +
 	"\abuckets\x18\x02 \x03(\v2E.sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfoR\abuckets\x12K\n" +
-	"\vcredentials\x18\x03 \x01(\v2).sigs.k8s.io.cosi.v1alpha2.CredentialInfoR\vcredentials\x1a\x82\x01\n" +
+	"\vcredentials\x18\x03 \x01(\v2).sigs.k8s.io.cosi.v1alpha2.CredentialInfoR\vcredentials\x1a\x87\x01\n" +
 	"\n" +
 	"BucketInfo\x12\x1b\n" +
-	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\x12W\n" +
-	"\vbucket_info\x18\x02 \x01(\v26.sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfoR\n" +
+	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\x12\\\n" +
+	"\vbucket_info\x18\x02 \x01(\v26.sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfoB\x03\xd8E\x01R\n" +
 	"bucketInfo\"\xd8\x04\n" +
 	"\x1fDriverRevokeBucketAccessRequest\x12\x1d\n" +
 	"\n" +
@@ -2132,15 +2520,35 @@ const file_cosi_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a-\n" +
 	"\x0eAccessedBucket\x12\x1b\n" +
 	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\"\"\n" +
-	" DriverRevokeBucketAccessResponse2\x80\x01\n" +
+	" DriverRevokeBucketAccessResponse\"\x8f\x05\n" +
+	"*DriverRotateBucketAccessCredentialsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12E\n" +
+	"\bprotocol\x18\x02 \x01(\v2).sigs.k8s.io.cosi.v1alpha2.ObjectProtocolR\bprotocol\x12^\n" +
+	"\x13authentication_type\x18\x03 \x01(\v2-.sigs.k8s.io.cosi.v1alpha2.AuthenticationTypeR\x12authenticationType\x12u\n" +
+	"\n" +
+	"parameters\x18\x04 \x03(\v2U.sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.ParametersEntryR\n" +
+	"parameters\x12n\n" +
+	"\abuckets\x18\x05 \x03(\v2T.sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.AccessedBucketR\abuckets\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1au\n" +
+	"\x0eAccessedBucket\x12\x1b\n" +
+	"\tbucket_id\x18\x01 \x01(\tR\bbucketId\x12F\n" +
+	"\vaccess_mode\x18\x02 \x01(\v2%.sigs.k8s.io.cosi.v1alpha2.AccessModeR\n" +
+	"accessMode\"z\n" +
+	"+DriverRotateBucketAccessCredentialsResponse\x12K\n" +
+	"\vcredentials\x18\x01 \x01(\v2).sigs.k8s.io.cosi.v1alpha2.CredentialInfoR\vcredentials2\xf0\x01\n" +
 	"\bIdentity\x12t\n" +
-	"\rDriverGetInfo\x12/.sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest\x1a0.sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse\"\x002\xd7\x05\n" +
+	"\rDriverGetInfo\x12/.sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest\x1a0.sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse\"\x00\x12n\n" +
+	"\vDriverProbe\x12-.sigs.k8s.io.cosi.v1alpha2.DriverProbeRequest\x1a..sigs.k8s.io.cosi.v1alpha2.DriverProbeResponse\"\x002\x8e\a\n" +
 	"\vProvisioner\x12\x83\x01\n" +
 	"\x12DriverCreateBucket\x124.sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest\x1a5.sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse\"\x00\x12\x92\x01\n" +
 	"\x17DriverGetExistingBucket\x129.sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest\x1a:.sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse\"\x00\x12\x83\x01\n" +
 	"\x12DriverDeleteBucket\x124.sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest\x1a5.sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketResponse\"\x00\x12\x90\x01\n" +
 	"\x17DriverGrantBucketAccess\x129.sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest\x1a:.sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse\x12\x93\x01\n" +
-	"\x18DriverRevokeBucketAccess\x12:.sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest\x1a;.sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse:<\n" +
+	"\x18DriverRevokeBucketAccess\x12:.sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest\x1a;.sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse\x12\xb4\x01\n" +
+	"#DriverRotateBucketAccessCredentials\x12E.sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest\x1aF.sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsResponse:<\n" +
 	"\n" +
 	"alpha_enum\x12\x1c.google.protobuf.EnumOptions\x18\xdc\b \x01(\bR\talphaEnum:L\n" +
 	"\x10alpha_enum_value\x12!.google.protobuf.EnumValueOptions\x18\xdc\b \x01(\bR\x0ealphaEnumValue:?\n" +
@@ -2165,107 +2573,126 @@ func file_cosi_proto_rawDescGZIP() []byte {
 }
 
 var file_cosi_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_cosi_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_cosi_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_cosi_proto_goTypes = []any{
-	(ObjectProtocol_Type)(0),                              // 0: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.Type
-	(S3AddressingStyle_Style)(0),                          // 1: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.Style
-	(AuthenticationType_Type)(0),                          // 2: sigs.k8s.io.cosi.v1alpha2.AuthenticationType.Type
-	(AccessMode_Mode)(0),                                  // 3: sigs.k8s.io.cosi.v1alpha2.AccessMode.Mode
-	(*DriverGetInfoRequest)(nil),                          // 4: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest
-	(*DriverGetInfoResponse)(nil),                         // 5: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse
-	(*ObjectProtocol)(nil),                                // 6: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	(*ObjectProtocolAndBucketInfo)(nil),                   // 7: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
-	(*CredentialInfo)(nil),                                // 8: sigs.k8s.io.cosi.v1alpha2.CredentialInfo
-	(*S3BucketInfo)(nil),                                  // 9: sigs.k8s.io.cosi.v1alpha2.S3BucketInfo
-	(*S3CredentialInfo)(nil),                              // 10: sigs.k8s.io.cosi.v1alpha2.S3CredentialInfo
-	(*S3AddressingStyle)(nil),                             // 11: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle
-	(*AzureBucketInfo)(nil),                               // 12: sigs.k8s.io.cosi.v1alpha2.AzureBucketInfo
-	(*AzureCredentialInfo)(nil),                           // 13: sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfo
-	(*GcsBucketInfo)(nil),                                 // 14: sigs.k8s.io.cosi.v1alpha2.GcsBucketInfo
-	(*GcsCredentialInfo)(nil),                             // 15: sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfo
-	(*AuthenticationType)(nil),                            // 16: sigs.k8s.io.cosi.v1alpha2.AuthenticationType
-	(*AccessMode)(nil),                                    // 17: sigs.k8s.io.cosi.v1alpha2.AccessMode
-	(*DriverCreateBucketRequest)(nil),                     // 18: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest
-	(*DriverCreateBucketResponse)(nil),                    // 19: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse
-	(*DriverGetExistingBucketRequest)(nil),                // 20: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest
-	(*DriverGetExistingBucketResponse)(nil),               // 21: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse
-	(*DriverDeleteBucketRequest)(nil),                     // 22: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest
-	(*DriverDeleteBucketResponse)(nil),                    // 23: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketResponse
-	(*DriverGrantBucketAccessRequest)(nil),                // 24: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest
-	(*DriverGrantBucketAccessResponse)(nil),               // 25: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse
-	(*DriverRevokeBucketAccessRequest)(nil),               // 26: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest
-	(*DriverRevokeBucketAccessResponse)(nil),              // 27: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse
-	nil,                                                   // 28: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.ParametersEntry
-	nil,                                                   // 29: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.ParametersEntry
-	nil,                                                   // 30: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.ParametersEntry
-	nil,                                                   // 31: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.ParametersEntry
-	(*DriverGrantBucketAccessRequest_AccessedBucket)(nil), // 32: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket
-	(*DriverGrantBucketAccessResponse_BucketInfo)(nil),    // 33: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo
-	nil, // 34: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.ParametersEntry
-	(*DriverRevokeBucketAccessRequest_AccessedBucket)(nil), // 35: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.AccessedBucket
-	(*descriptorpb.EnumOptions)(nil),                       // 36: google.protobuf.EnumOptions
-	(*descriptorpb.EnumValueOptions)(nil),                  // 37: google.protobuf.EnumValueOptions
-	(*descriptorpb.FieldOptions)(nil),                      // 38: google.protobuf.FieldOptions
-	(*descriptorpb.MessageOptions)(nil),                    // 39: google.protobuf.MessageOptions
-	(*descriptorpb.MethodOptions)(nil),                     // 40: google.protobuf.MethodOptions
-	(*descriptorpb.ServiceOptions)(nil),                    // 41: google.protobuf.ServiceOptions
+	(ObjectProtocol_Type)(0),                            // 0: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.Type
+	(S3AddressingStyle_Style)(0),                        // 1: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.Style
+	(AuthenticationType_Type)(0),                        // 2: sigs.k8s.io.cosi.v1alpha2.AuthenticationType.Type
+	(AccessMode_Mode)(0),                                // 3: sigs.k8s.io.cosi.v1alpha2.AccessMode.Mode
+	(*DriverGetInfoRequest)(nil),                        // 4: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest
+	(*DriverGetInfoResponse)(nil),                       // 5: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse
+	(*DriverCapabilities)(nil),                          // 6: sigs.k8s.io.cosi.v1alpha2.DriverCapabilities
+	(*DriverProbeRequest)(nil),                          // 7: sigs.k8s.io.cosi.v1alpha2.DriverProbeRequest
+	(*DriverProbeResponse)(nil),                         // 8: sigs.k8s.io.cosi.v1alpha2.DriverProbeResponse
+	(*ObjectProtocol)(nil),                              // 9: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	(*ObjectProtocolAndBucketInfo)(nil),                 // 10: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
+	(*CredentialInfo)(nil),                              // 11: sigs.k8s.io.cosi.v1alpha2.CredentialInfo
+	(*S3BucketInfo)(nil),                                // 12: sigs.k8s.io.cosi.v1alpha2.S3BucketInfo
+	(*S3CredentialInfo)(nil),                            // 13: sigs.k8s.io.cosi.v1alpha2.S3CredentialInfo
+	(*S3AddressingStyle)(nil),                           // 14: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle
+	(*AzureBucketInfo)(nil),                             // 15: sigs.k8s.io.cosi.v1alpha2.AzureBucketInfo
+	(*AzureCredentialInfo)(nil),                         // 16: sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfo
+	(*GcsBucketInfo)(nil),                               // 17: sigs.k8s.io.cosi.v1alpha2.GcsBucketInfo
+	(*GcsCredentialInfo)(nil),                           // 18: sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfo
+	(*AuthenticationType)(nil),                          // 19: sigs.k8s.io.cosi.v1alpha2.AuthenticationType
+	(*AccessMode)(nil),                                  // 20: sigs.k8s.io.cosi.v1alpha2.AccessMode
+	(*DriverCreateBucketRequest)(nil),                   // 21: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest
+	(*DriverCreateBucketResponse)(nil),                  // 22: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse
+	(*DriverGetExistingBucketRequest)(nil),              // 23: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest
+	(*DriverGetExistingBucketResponse)(nil),             // 24: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse
+	(*DriverDeleteBucketRequest)(nil),                   // 25: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest
+	(*DriverDeleteBucketResponse)(nil),                  // 26: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketResponse
+	(*DriverGrantBucketAccessRequest)(nil),              // 27: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest
+	(*DriverGrantBucketAccessResponse)(nil),             // 28: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse
+	(*DriverRevokeBucketAccessRequest)(nil),             // 29: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest
+	(*DriverRevokeBucketAccessResponse)(nil),            // 30: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse
+	(*DriverRotateBucketAccessCredentialsRequest)(nil),  // 31: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest
+	(*DriverRotateBucketAccessCredentialsResponse)(nil), // 32: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsResponse
+	nil, // 33: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.ParametersEntry
+	nil, // 34: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.ParametersEntry
+	nil, // 35: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.ParametersEntry
+	nil, // 36: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.ParametersEntry
+	(*DriverGrantBucketAccessRequest_AccessedBucket)(nil), // 37: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket
+	(*DriverGrantBucketAccessResponse_BucketInfo)(nil),    // 38: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo
+	nil, // 39: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.ParametersEntry
+	(*DriverRevokeBucketAccessRequest_AccessedBucket)(nil), // 40: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.AccessedBucket
+	nil, // 41: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.ParametersEntry
+	(*DriverRotateBucketAccessCredentialsRequest_AccessedBucket)(nil), // 42: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.AccessedBucket
+	(*descriptorpb.EnumOptions)(nil),                                  // 43: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil),                             // 44: google.protobuf.EnumValueOptions
+	(*descriptorpb.FieldOptions)(nil),                                 // 45: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil),                               // 46: google.protobuf.MessageOptions
+	(*descriptorpb.MethodOptions)(nil),                                // 47: google.protobuf.MethodOptions
+	(*descriptorpb.ServiceOptions)(nil),                               // 48: google.protobuf.ServiceOptions
 }
 var file_cosi_proto_depIdxs = []int32{
-	6,  // 0: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse.supported_protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	0,  // 1: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.type:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.Type
-	9,  // 2: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.s3:type_name -> sigs.k8s.io.cosi.v1alpha2.S3BucketInfo
-	12, // 3: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.azure:type_name -> sigs.k8s.io.cosi.v1alpha2.AzureBucketInfo
-	14, // 4: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.gcs:type_name -> sigs.k8s.io.cosi.v1alpha2.GcsBucketInfo
-	10, // 5: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.s3:type_name -> sigs.k8s.io.cosi.v1alpha2.S3CredentialInfo
-	13, // 6: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.azure:type_name -> sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfo
-	15, // 7: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.gcs:type_name -> sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfo
-	11, // 8: sigs.k8s.io.cosi.v1alpha2.S3BucketInfo.addressing_style:type_name -> sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle
-	1,  // 9: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.style:type_name -> sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.Style
-	2,  // 10: sigs.k8s.io.cosi.v1alpha2.AuthenticationType.type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType.Type
-	3,  // 11: sigs.k8s.io.cosi.v1alpha2.AccessMode.mode:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode.Mode
-	6,  // 12: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	28, // 13: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.ParametersEntry
-	7,  // 14: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
-	6,  // 15: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	29, // 16: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.ParametersEntry
-	7,  // 17: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
-	30, // 18: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.ParametersEntry
-	6,  // 19: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.protocol:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	16, // 20: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.authentication_type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType
-	31, // 21: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.ParametersEntry
-	32, // 22: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket
-	33, // 23: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo
-	8,  // 24: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.credentials:type_name -> sigs.k8s.io.cosi.v1alpha2.CredentialInfo
-	6,  // 25: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.protocol:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
-	16, // 26: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.authentication_type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType
-	34, // 27: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.ParametersEntry
-	35, // 28: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.AccessedBucket
-	17, // 29: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket.access_mode:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode
-	7,  // 30: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo.bucket_info:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
-	36, // 31: sigs.k8s.io.cosi.v1alpha2.alpha_enum:extendee -> google.protobuf.EnumOptions
-	37, // 32: sigs.k8s.io.cosi.v1alpha2.alpha_enum_value:extendee -> google.protobuf.EnumValueOptions
-	38, // 33: sigs.k8s.io.cosi.v1alpha2.cosi_secret:extendee -> google.protobuf.FieldOptions
-	38, // 34: sigs.k8s.io.cosi.v1alpha2.alpha_field:extendee -> google.protobuf.FieldOptions
-	39, // 35: sigs.k8s.io.cosi.v1alpha2.alpha_message:extendee -> google.protobuf.MessageOptions
-	40, // 36: sigs.k8s.io.cosi.v1alpha2.alpha_method:extendee -> google.protobuf.MethodOptions
-	41, // 37: sigs.k8s.io.cosi.v1alpha2.alpha_service:extendee -> google.protobuf.ServiceOptions
-	4,  // 38: sigs.k8s.io.cosi.v1alpha2.Identity.DriverGetInfo:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest
-	18, // 39: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverCreateBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest
-	20, // 40: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGetExistingBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest
-	22, // 41: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverDeleteBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest
-	24, // 42: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGrantBucketAccess:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest
-	26, // 43: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRevokeBucketAccess:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest
-	5,  // 44: sigs.k8s.io.cosi.v1alpha2.Identity.DriverGetInfo:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse
-	19, // 45: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverCreateBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse
-	21, // 46: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGetExistingBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse
-	23, // 47: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverDeleteBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketResponse
-	25, // 48: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGrantBucketAccess:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse
-	27, // 49: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRevokeBucketAccess:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse
-	44, // [44:50] is the sub-list for method output_type
-	38, // [38:44] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	31, // [31:38] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	9,  // 0: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse.supported_protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	6,  // 1: sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse.capabilities:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverCapabilities
+	20, // 2: sigs.k8s.io.cosi.v1alpha2.DriverCapabilities.supported_access_modes:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode
+	0,  // 3: sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.type:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol.Type
+	12, // 4: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.s3:type_name -> sigs.k8s.io.cosi.v1alpha2.S3BucketInfo
+	15, // 5: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.azure:type_name -> sigs.k8s.io.cosi.v1alpha2.AzureBucketInfo
+	17, // 6: sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo.gcs:type_name -> sigs.k8s.io.cosi.v1alpha2.GcsBucketInfo
+	13, // 7: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.s3:type_name -> sigs.k8s.io.cosi.v1alpha2.S3CredentialInfo
+	16, // 8: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.azure:type_name -> sigs.k8s.io.cosi.v1alpha2.AzureCredentialInfo
+	18, // 9: sigs.k8s.io.cosi.v1alpha2.CredentialInfo.gcs:type_name -> sigs.k8s.io.cosi.v1alpha2.GcsCredentialInfo
+	14, // 10: sigs.k8s.io.cosi.v1alpha2.S3BucketInfo.addressing_style:type_name -> sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle
+	1,  // 11: sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.style:type_name -> sigs.k8s.io.cosi.v1alpha2.S3AddressingStyle.Style
+	2,  // 12: sigs.k8s.io.cosi.v1alpha2.AuthenticationType.type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType.Type
+	3,  // 13: sigs.k8s.io.cosi.v1alpha2.AccessMode.mode:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode.Mode
+	9,  // 14: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	33, // 15: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest.ParametersEntry
+	10, // 16: sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
+	9,  // 17: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	34, // 18: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest.ParametersEntry
+	10, // 19: sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse.protocols:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
+	35, // 20: sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest.ParametersEntry
+	9,  // 21: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.protocol:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	19, // 22: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.authentication_type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType
+	36, // 23: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.ParametersEntry
+	37, // 24: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket
+	38, // 25: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo
+	11, // 26: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.credentials:type_name -> sigs.k8s.io.cosi.v1alpha2.CredentialInfo
+	9,  // 27: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.protocol:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	19, // 28: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.authentication_type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType
+	39, // 29: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.ParametersEntry
+	40, // 30: sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest.AccessedBucket
+	9,  // 31: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.protocol:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocol
+	19, // 32: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.authentication_type:type_name -> sigs.k8s.io.cosi.v1alpha2.AuthenticationType
+	41, // 33: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.parameters:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.ParametersEntry
+	42, // 34: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.buckets:type_name -> sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.AccessedBucket
+	11, // 35: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsResponse.credentials:type_name -> sigs.k8s.io.cosi.v1alpha2.CredentialInfo
+	20, // 36: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest.AccessedBucket.access_mode:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode
+	10, // 37: sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse.BucketInfo.bucket_info:type_name -> sigs.k8s.io.cosi.v1alpha2.ObjectProtocolAndBucketInfo
+	20, // 38: sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest.AccessedBucket.access_mode:type_name -> sigs.k8s.io.cosi.v1alpha2.AccessMode
+	43, // 39: sigs.k8s.io.cosi.v1alpha2.alpha_enum:extendee -> google.protobuf.EnumOptions
+	44, // 40: sigs.k8s.io.cosi.v1alpha2.alpha_enum_value:extendee -> google.protobuf.EnumValueOptions
+	45, // 41: sigs.k8s.io.cosi.v1alpha2.cosi_secret:extendee -> google.protobuf.FieldOptions
+	45, // 42: sigs.k8s.io.cosi.v1alpha2.alpha_field:extendee -> google.protobuf.FieldOptions
+	46, // 43: sigs.k8s.io.cosi.v1alpha2.alpha_message:extendee -> google.protobuf.MessageOptions
+	47, // 44: sigs.k8s.io.cosi.v1alpha2.alpha_method:extendee -> google.protobuf.MethodOptions
+	48, // 45: sigs.k8s.io.cosi.v1alpha2.alpha_service:extendee -> google.protobuf.ServiceOptions
+	4,  // 46: sigs.k8s.io.cosi.v1alpha2.Identity.DriverGetInfo:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetInfoRequest
+	7,  // 47: sigs.k8s.io.cosi.v1alpha2.Identity.DriverProbe:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverProbeRequest
+	21, // 48: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverCreateBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketRequest
+	23, // 49: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGetExistingBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketRequest
+	25, // 50: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverDeleteBucket:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketRequest
+	27, // 51: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGrantBucketAccess:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessRequest
+	29, // 52: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRevokeBucketAccess:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessRequest
+	31, // 53: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRotateBucketAccessCredentials:input_type -> sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsRequest
+	5,  // 54: sigs.k8s.io.cosi.v1alpha2.Identity.DriverGetInfo:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetInfoResponse
+	8,  // 55: sigs.k8s.io.cosi.v1alpha2.Identity.DriverProbe:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverProbeResponse
+	22, // 56: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverCreateBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverCreateBucketResponse
+	24, // 57: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGetExistingBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGetExistingBucketResponse
+	26, // 58: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverDeleteBucket:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverDeleteBucketResponse
+	28, // 59: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverGrantBucketAccess:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverGrantBucketAccessResponse
+	30, // 60: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRevokeBucketAccess:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverRevokeBucketAccessResponse
+	32, // 61: sigs.k8s.io.cosi.v1alpha2.Provisioner.DriverRotateBucketAccessCredentials:output_type -> sigs.k8s.io.cosi.v1alpha2.DriverRotateBucketAccessCredentialsResponse
+	54, // [54:62] is the sub-list for method output_type
+	46, // [46:54] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	39, // [39:46] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_cosi_proto_init() }
@@ -2279,7 +2706,7 @@ func file_cosi_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cosi_proto_rawDesc), len(file_cosi_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   32,
+			NumMessages:   39,
 			NumExtensions: 7,
 			NumServices:   2,
 		},
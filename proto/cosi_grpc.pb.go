@@ -22,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Identity_DriverGetInfo_FullMethodName = "/sigs.k8s.io.cosi.v1alpha2.Identity/DriverGetInfo"
+	Identity_DriverProbe_FullMethodName   = "/sigs.k8s.io.cosi.v1alpha2.Identity/DriverProbe"
 )
 
 // IdentityClient is the client API for Identity service.
@@ -30,6 +31,13 @@ const (
 type IdentityClient interface {
 	// Retrieve the unique provisioner identity.
 	DriverGetInfo(ctx context.Context, in *DriverGetInfoRequest, opts ...grpc.CallOption) (*DriverGetInfoResponse, error)
+	// Check the health and readiness of the driver.
+	//
+	// Important return codes:
+	// - MUST return OK if the driver is healthy, whether or not it is ready.
+	// - MUST return UNIMPLEMENTED if the driver does not support health probes. COSI then
+	//   determines driver health from the state of the gRPC connection only.
+	DriverProbe(ctx context.Context, in *DriverProbeRequest, opts ...grpc.CallOption) (*DriverProbeResponse, error)
 }
 
 type identityClient struct {
@@ -49,12 +57,28 @@ func (c *identityClient) DriverGetInfo(ctx context.Context, in *DriverGetInfoReq
 	return out, nil
 }
 
+func (c *identityClient) DriverProbe(ctx context.Context, in *DriverProbeRequest, opts ...grpc.CallOption) (*DriverProbeResponse, error) {
+	out := new(DriverProbeResponse)
+	err := c.cc.Invoke(ctx, Identity_DriverProbe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IdentityServer is the server API for Identity service.
 // All implementations must embed UnimplementedIdentityServer
 // for forward compatibility
 type IdentityServer interface {
 	// Retrieve the unique provisioner identity.
 	DriverGetInfo(context.Context, *DriverGetInfoRequest) (*DriverGetInfoResponse, error)
+	// Check the health and readiness of the driver.
+	//
+	// Important return codes:
+	// - MUST return OK if the driver is healthy, whether or not it is ready.
+	// - MUST return UNIMPLEMENTED if the driver does not support health probes. COSI then
+	//   determines driver health from the state of the gRPC connection only.
+	DriverProbe(context.Context, *DriverProbeRequest) (*DriverProbeResponse, error)
 	mustEmbedUnimplementedIdentityServer()
 }
 
@@ -65,6 +89,9 @@ type UnimplementedIdentityServer struct {
 func (UnimplementedIdentityServer) DriverGetInfo(context.Context, *DriverGetInfoRequest) (*DriverGetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DriverGetInfo not implemented")
 }
+func (UnimplementedIdentityServer) DriverProbe(context.Context, *DriverProbeRequest) (*DriverProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DriverProbe not implemented")
+}
 func (UnimplementedIdentityServer) mustEmbedUnimplementedIdentityServer() {}
 
 // UnsafeIdentityServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +123,24 @@ func _Identity_DriverGetInfo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Identity_DriverProbe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServer).DriverProbe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Identity_DriverProbe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServer).DriverProbe(ctx, req.(*DriverProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Identity_ServiceDesc is the grpc.ServiceDesc for Identity service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,17 +152,22 @@ var Identity_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DriverGetInfo",
 			Handler:    _Identity_DriverGetInfo_Handler,
 		},
+		{
+			MethodName: "DriverProbe",
+			Handler:    _Identity_DriverProbe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosi.proto",
 }
 
 const (
-	Provisioner_DriverCreateBucket_FullMethodName       = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverCreateBucket"
-	Provisioner_DriverGetExistingBucket_FullMethodName  = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverGetExistingBucket"
-	Provisioner_DriverDeleteBucket_FullMethodName       = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverDeleteBucket"
-	Provisioner_DriverGrantBucketAccess_FullMethodName  = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverGrantBucketAccess"
-	Provisioner_DriverRevokeBucketAccess_FullMethodName = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverRevokeBucketAccess"
+	Provisioner_DriverCreateBucket_FullMethodName                  = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverCreateBucket"
+	Provisioner_DriverGetExistingBucket_FullMethodName             = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverGetExistingBucket"
+	Provisioner_DriverDeleteBucket_FullMethodName                  = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverDeleteBucket"
+	Provisioner_DriverGrantBucketAccess_FullMethodName             = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverGrantBucketAccess"
+	Provisioner_DriverRevokeBucketAccess_FullMethodName            = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverRevokeBucketAccess"
+	Provisioner_DriverRotateBucketAccessCredentials_FullMethodName = "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverRotateBucketAccessCredentials"
 )
 
 // ProvisionerClient is the client API for Provisioner service.
@@ -153,6 +203,12 @@ type ProvisionerClient interface {
 	// Important return codes:
 	// - MUST return OK if access has already been removed from a principal.
 	DriverRevokeBucketAccess(ctx context.Context, in *DriverRevokeBucketAccessRequest, opts ...grpc.CallOption) (*DriverRevokeBucketAccessResponse, error)
+	// Rotate the credentials of a principal that was previously granted access.
+	//
+	// Important return codes:
+	// - MUST return NOT_FOUND if a principal with matching identity does not exist.
+	// - MUST return UNIMPLEMENTED if the driver/backend does not support credential rotation.
+	DriverRotateBucketAccessCredentials(ctx context.Context, in *DriverRotateBucketAccessCredentialsRequest, opts ...grpc.CallOption) (*DriverRotateBucketAccessCredentialsResponse, error)
 }
 
 type provisionerClient struct {
@@ -208,6 +264,15 @@ func (c *provisionerClient) DriverRevokeBucketAccess(ctx context.Context, in *Dr
 	return out, nil
 }
 
+func (c *provisionerClient) DriverRotateBucketAccessCredentials(ctx context.Context, in *DriverRotateBucketAccessCredentialsRequest, opts ...grpc.CallOption) (*DriverRotateBucketAccessCredentialsResponse, error) {
+	out := new(DriverRotateBucketAccessCredentialsResponse)
+	err := c.cc.Invoke(ctx, Provisioner_DriverRotateBucketAccessCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProvisionerServer is the server API for Provisioner service.
 // All implementations must embed UnimplementedProvisionerServer
 // for forward compatibility
@@ -241,6 +306,12 @@ type ProvisionerServer interface {
 	// Important return codes:
 	// - MUST return OK if access has already been removed from a principal.
 	DriverRevokeBucketAccess(context.Context, *DriverRevokeBucketAccessRequest) (*DriverRevokeBucketAccessResponse, error)
+	// Rotate the credentials of a principal that was previously granted access.
+	//
+	// Important return codes:
+	// - MUST return NOT_FOUND if a principal with matching identity does not exist.
+	// - MUST return UNIMPLEMENTED if the driver/backend does not support credential rotation.
+	DriverRotateBucketAccessCredentials(context.Context, *DriverRotateBucketAccessCredentialsRequest) (*DriverRotateBucketAccessCredentialsResponse, error)
 	mustEmbedUnimplementedProvisionerServer()
 }
 
@@ -263,6 +334,9 @@ func (UnimplementedProvisionerServer) DriverGrantBucketAccess(context.Context, *
 func (UnimplementedProvisionerServer) DriverRevokeBucketAccess(context.Context, *DriverRevokeBucketAccessRequest) (*DriverRevokeBucketAccessResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DriverRevokeBucketAccess not implemented")
 }
+func (UnimplementedProvisionerServer) DriverRotateBucketAccessCredentials(context.Context, *DriverRotateBucketAccessCredentialsRequest) (*DriverRotateBucketAccessCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DriverRotateBucketAccessCredentials not implemented")
+}
 func (UnimplementedProvisionerServer) mustEmbedUnimplementedProvisionerServer() {}
 
 // UnsafeProvisionerServer may be embedded to opt out of forward compatibility for this service.
@@ -366,6 +440,24 @@ func _Provisioner_DriverRevokeBucketAccess_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provisioner_DriverRotateBucketAccessCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverRotateBucketAccessCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProvisionerServer).DriverRotateBucketAccessCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Provisioner_DriverRotateBucketAccessCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProvisionerServer).DriverRotateBucketAccessCredentials(ctx, req.(*DriverRotateBucketAccessCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Provisioner_ServiceDesc is the grpc.ServiceDesc for Provisioner service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -393,6 +485,10 @@ var Provisioner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DriverRevokeBucketAccess",
 			Handler:    _Provisioner_DriverRevokeBucketAccess_Handler,
 		},
+		{
+			MethodName: "DriverRotateBucketAccessCredentials",
+			Handler:    _Provisioner_DriverRotateBucketAccessCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosi.proto",
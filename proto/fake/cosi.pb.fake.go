@@ -8,18 +8,23 @@ import (
 
 type FakeIdentityClient struct {
 	FakeDriverGetInfo func(ctx context.Context, in *proto.DriverGetInfoRequest, opts ...grpc.CallOption) (*proto.DriverGetInfoResponse, error)
+	FakeDriverProbe   func(ctx context.Context, in *proto.DriverProbeRequest, opts ...grpc.CallOption) (*proto.DriverProbeResponse, error)
 }
 
 func (f *FakeIdentityClient) DriverGetInfo(ctx context.Context, in *proto.DriverGetInfoRequest, opts ...grpc.CallOption) (*proto.DriverGetInfoResponse, error) {
 	return f.FakeDriverGetInfo(ctx, in, opts...)
 }
+func (f *FakeIdentityClient) DriverProbe(ctx context.Context, in *proto.DriverProbeRequest, opts ...grpc.CallOption) (*proto.DriverProbeResponse, error) {
+	return f.FakeDriverProbe(ctx, in, opts...)
+}
 
 type FakeProvisionerClient struct {
-	FakeDriverCreateBucket       func(ctx context.Context, in *proto.DriverCreateBucketRequest, opts ...grpc.CallOption) (*proto.DriverCreateBucketResponse, error)
-	FakeDriverGetExistingBucket  func(ctx context.Context, in *proto.DriverGetExistingBucketRequest, opts ...grpc.CallOption) (*proto.DriverGetExistingBucketResponse, error)
-	FakeDriverDeleteBucket       func(ctx context.Context, in *proto.DriverDeleteBucketRequest, opts ...grpc.CallOption) (*proto.DriverDeleteBucketResponse, error)
-	FakeDriverGrantBucketAccess  func(ctx context.Context, in *proto.DriverGrantBucketAccessRequest, opts ...grpc.CallOption) (*proto.DriverGrantBucketAccessResponse, error)
-	FakeDriverRevokeBucketAccess func(ctx context.Context, in *proto.DriverRevokeBucketAccessRequest, opts ...grpc.CallOption) (*proto.DriverRevokeBucketAccessResponse, error)
+	FakeDriverCreateBucket                  func(ctx context.Context, in *proto.DriverCreateBucketRequest, opts ...grpc.CallOption) (*proto.DriverCreateBucketResponse, error)
+	FakeDriverGetExistingBucket             func(ctx context.Context, in *proto.DriverGetExistingBucketRequest, opts ...grpc.CallOption) (*proto.DriverGetExistingBucketResponse, error)
+	FakeDriverDeleteBucket                  func(ctx context.Context, in *proto.DriverDeleteBucketRequest, opts ...grpc.CallOption) (*proto.DriverDeleteBucketResponse, error)
+	FakeDriverGrantBucketAccess             func(ctx context.Context, in *proto.DriverGrantBucketAccessRequest, opts ...grpc.CallOption) (*proto.DriverGrantBucketAccessResponse, error)
+	FakeDriverRevokeBucketAccess            func(ctx context.Context, in *proto.DriverRevokeBucketAccessRequest, opts ...grpc.CallOption) (*proto.DriverRevokeBucketAccessResponse, error)
+	FakeDriverRotateBucketAccessCredentials func(ctx context.Context, in *proto.DriverRotateBucketAccessCredentialsRequest, opts ...grpc.CallOption) (*proto.DriverRotateBucketAccessCredentialsResponse, error)
 }
 
 func (f *FakeProvisionerClient) DriverCreateBucket(ctx context.Context, in *proto.DriverCreateBucketRequest, opts ...grpc.CallOption) (*proto.DriverCreateBucketResponse, error) {
@@ -37,3 +42,6 @@ func (f *FakeProvisionerClient) DriverGrantBucketAccess(ctx context.Context, in
 func (f *FakeProvisionerClient) DriverRevokeBucketAccess(ctx context.Context, in *proto.DriverRevokeBucketAccessRequest, opts ...grpc.CallOption) (*proto.DriverRevokeBucketAccessResponse, error) {
 	return f.FakeDriverRevokeBucketAccess(ctx, in, opts...)
 }
+func (f *FakeProvisionerClient) DriverRotateBucketAccessCredentials(ctx context.Context, in *proto.DriverRotateBucketAccessCredentialsRequest, opts ...grpc.CallOption) (*proto.DriverRotateBucketAccessCredentialsResponse, error) {
+	return f.FakeDriverRotateBucketAccessCredentials(ctx, in, opts...)
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+var errDriverProbeUnimplemented = errors.New("driver does not implement DriverProbe")
+
+// Connection whose state can be observed. Implemented by *grpc.ClientConn.
+type connectionStateGetter interface {
+	GetState() connectivity.State
+}
+
+// driverHealth periodically probes the driver and reports the results as readiness and liveness
+// checks for the Sidecar's health probe endpoint.
+// The Sidecar is ready only when the driver connection is usable and the most recent probe
+// succeeded. The Sidecar is live unless the driver has been unhealthy for longer than the liveness
+// timeout, which indicates a problem that a restart might resolve.
+type driverHealth struct {
+	conn     connectionStateGetter
+	identity cosiproto.IdentityClient
+
+	probeInterval   time.Duration
+	livenessTimeout time.Duration
+
+	// current time - overrideable for unit tests
+	now func() time.Time
+
+	mu sync.Mutex
+	// error from the most recent health evaluation, nil if healthy
+	lastErr error
+	// time of the most recent health evaluation, zero until the first probe
+	lastProbeTime time.Time
+	// time at which the driver was last known to be healthy
+	lastHealthyTime time.Time
+	// set once the driver is found not to implement DriverProbe
+	probeUnimplemented bool
+}
+
+func newDriverHealth(
+	conn connectionStateGetter,
+	identity cosiproto.IdentityClient,
+	probeInterval, livenessTimeout time.Duration,
+) *driverHealth {
+	return &driverHealth{
+		conn:            conn,
+		identity:        identity,
+		probeInterval:   probeInterval,
+		livenessTimeout: livenessTimeout,
+		now:             time.Now,
+		// the driver was healthy when the Sidecar connected to it
+		lastHealthyTime: time.Now(),
+	}
+}
+
+// Start polls the driver until the context is done. Implements manager.Runnable.
+func (h *driverHealth) Start(ctx context.Context) error {
+	ticker := time.NewTicker(h.probeInterval)
+	defer ticker.Stop()
+
+	for {
+		h.probe(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// All Sidecar replicas report their own driver health.
+func (h *driverHealth) NeedLeaderElection() bool {
+	return false
+}
+
+// Probe the driver, and record the result.
+func (h *driverHealth) probe(ctx context.Context) {
+	err := h.evaluate(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	if err != nil && !errors.Is(err, errDriverProbeUnimplemented) {
+		if h.lastErr == nil {
+			logger.Error(err, "driver is unhealthy")
+		}
+		h.lastErr = err
+	} else {
+		if h.lastErr != nil {
+			logger.Info("driver is healthy")
+		}
+		h.lastErr = nil
+		h.lastHealthyTime = now
+	}
+	h.lastProbeTime = now
+
+	if errors.Is(err, errDriverProbeUnimplemented) && !h.probeUnimplemented {
+		logger.Info("driver does not implement DriverProbe; health is based on connection state only")
+		h.probeUnimplemented = true
+	}
+}
+
+// Determine driver health from the connection state and the DriverProbe RPC.
+func (h *driverHealth) evaluate(ctx context.Context) error {
+	// The client reconnects automatically. A connection that is idle or connecting might still be
+	// usable once an RPC is attempted, so let the probe decide in those cases.
+	switch state := h.conn.GetState(); state {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("driver connection state is %s", state)
+	}
+
+	h.mu.Lock()
+	unimplemented := h.probeUnimplemented
+	h.mu.Unlock()
+	if unimplemented {
+		return errDriverProbeUnimplemented
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, h.probeInterval)
+	defer cancel()
+
+	resp, err := h.identity.DriverProbe(probeCtx, &cosiproto.DriverProbeRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return errDriverProbeUnimplemented
+		}
+		return fmt.Errorf("DriverProbe failed: %w", err)
+	}
+	if !resp.GetReady() {
+		return fmt.Errorf("driver reports that it is not ready")
+	}
+	return nil
+}
+
+// ReadyzCheck reports an error unless the most recent driver probe succeeded.
+// Implements healthz.Checker.
+func (h *driverHealth) ReadyzCheck(_ *http.Request) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.lastProbeTime.IsZero() {
+		return fmt.Errorf("driver has not been probed yet")
+	}
+	if h.lastErr != nil {
+		return h.lastErr
+	}
+	// Probes are polled in the background. If they stop, the last result can't be trusted.
+	if age := h.now().Sub(h.lastProbeTime); age > 3*h.probeInterval {
+		return fmt.Errorf("most recent driver probe is stale: %s old", age.Round(time.Second))
+	}
+	return nil
+}
+
+// HealthzCheck reports an error if the driver has been unhealthy for longer than the liveness
+// timeout. Implements healthz.Checker.
+func (h *driverHealth) HealthzCheck(_ *http.Request) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	unhealthyFor := h.now().Sub(h.lastHealthyTime)
+	if unhealthyFor <= h.livenessTimeout {
+		return nil
+	}
+	if h.lastErr == nil {
+		return fmt.Errorf("driver has not been probed for %s", unhealthyFor.Round(time.Second))
+	}
+	return fmt.Errorf("driver has been unhealthy for %s: %w", unhealthyFor.Round(time.Second), h.lastErr)
+}
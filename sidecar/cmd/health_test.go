@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+func Test_driverHealth(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+
+	newTestHealth := func(conn *fakeConn, identity *fakeProbeClient) (*driverHealth, *time.Time) {
+		now := start
+		h := newDriverHealth(conn, identity, 10*time.Second, time.Minute)
+		h.now = func() time.Time { return now }
+		h.lastHealthyTime = start
+		return h, &now
+	}
+
+	t.Run("not yet probed", func(t *testing.T) {
+		h, _ := newTestHealth(&fakeConn{state: connectivity.Ready}, &fakeProbeClient{ready: true})
+
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "not been probed")
+		assert.NoError(t, h.HealthzCheck(nil))
+	})
+
+	t.Run("probe ready", func(t *testing.T) {
+		identity := &fakeProbeClient{ready: true}
+		h, _ := newTestHealth(&fakeConn{state: connectivity.Ready}, identity)
+
+		h.probe(ctx)
+		assert.Equal(t, 1, identity.calls)
+		assert.NoError(t, h.ReadyzCheck(nil))
+		assert.NoError(t, h.HealthzCheck(nil))
+	})
+
+	t.Run("probe not ready", func(t *testing.T) {
+		h, now := newTestHealth(&fakeConn{state: connectivity.Ready}, &fakeProbeClient{ready: false})
+
+		h.probe(ctx)
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "not ready")
+		assert.NoError(t, h.HealthzCheck(nil))
+
+		// liveness fails only after the driver has been unhealthy for longer than the timeout
+		*now = start.Add(2 * time.Minute)
+		h.probe(ctx)
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "not ready")
+		assert.ErrorContains(t, h.HealthzCheck(nil), "unhealthy for 2m0s")
+	})
+
+	t.Run("probe error, then recovery", func(t *testing.T) {
+		identity := &fakeProbeClient{err: status.Error(codes.Unavailable, "fake error")}
+		h, now := newTestHealth(&fakeConn{state: connectivity.Ready}, identity)
+
+		h.probe(ctx)
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "fake error")
+
+		identity.err = nil
+		identity.ready = true
+		*now = start.Add(2 * time.Minute)
+		h.probe(ctx)
+		assert.NoError(t, h.ReadyzCheck(nil))
+		assert.NoError(t, h.HealthzCheck(nil))
+	})
+
+	t.Run("probe unimplemented", func(t *testing.T) {
+		identity := &fakeProbeClient{err: status.Error(codes.Unimplemented, "unimplemented")}
+		conn := &fakeConn{state: connectivity.Ready}
+		h, _ := newTestHealth(conn, identity)
+
+		h.probe(ctx)
+		assert.NoError(t, h.ReadyzCheck(nil))
+		assert.NoError(t, h.HealthzCheck(nil))
+
+		// probe isn't called again, but connection state is still checked
+		h.probe(ctx)
+		assert.Equal(t, 1, identity.calls)
+		assert.NoError(t, h.ReadyzCheck(nil))
+
+		conn.state = connectivity.TransientFailure
+		h.probe(ctx)
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "TRANSIENT_FAILURE")
+	})
+
+	t.Run("connection failure", func(t *testing.T) {
+		for _, state := range []connectivity.State{connectivity.TransientFailure, connectivity.Shutdown} {
+			t.Run(state.String(), func(t *testing.T) {
+				identity := &fakeProbeClient{ready: true}
+				h, _ := newTestHealth(&fakeConn{state: state}, identity)
+
+				h.probe(ctx)
+				assert.Equal(t, 0, identity.calls)
+				assert.ErrorContains(t, h.ReadyzCheck(nil), state.String())
+			})
+		}
+	})
+
+	t.Run("connection idle or connecting", func(t *testing.T) {
+		for _, state := range []connectivity.State{connectivity.Idle, connectivity.Connecting} {
+			t.Run(state.String(), func(t *testing.T) {
+				identity := &fakeProbeClient{ready: true}
+				h, _ := newTestHealth(&fakeConn{state: state}, identity)
+
+				h.probe(ctx)
+				assert.Equal(t, 1, identity.calls)
+				assert.NoError(t, h.ReadyzCheck(nil))
+			})
+		}
+	})
+
+	t.Run("stale probe", func(t *testing.T) {
+		h, now := newTestHealth(&fakeConn{state: connectivity.Ready}, &fakeProbeClient{ready: true})
+
+		h.probe(ctx)
+		*now = start.Add(31 * time.Second)
+		assert.ErrorContains(t, h.ReadyzCheck(nil), "stale")
+		assert.NoError(t, h.HealthzCheck(nil))
+
+		*now = start.Add(2 * time.Minute)
+		assert.ErrorContains(t, h.HealthzCheck(nil), "not been probed for 2m0s")
+	})
+}
+
+type fakeConn struct {
+	state connectivity.State
+}
+
+func (c *fakeConn) GetState() connectivity.State {
+	return c.state
+}
+
+type fakeProbeClient struct {
+	cosiproto.IdentityClient // only DriverProbe is implemented
+
+	ready bool
+	err   error
+	calls int
+}
+
+func (c *fakeProbeClient) DriverProbe(
+	_ context.Context, _ *cosiproto.DriverProbeRequest, _ ...grpc.CallOption,
+) (*cosiproto.DriverProbeResponse, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &cosiproto.DriverProbeResponse{Ready: c.ready}, nil
+}
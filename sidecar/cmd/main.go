@@ -17,27 +17,47 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+	"sigs.k8s.io/container-object-storage-interface/sidecar/internal/rpclog"
+	"sigs.k8s.io/container-object-storage-interface/sidecar/internal/rpcmetrics"
+	"sigs.k8s.io/container-object-storage-interface/sidecar/internal/rpctrace"
 	reconciler "sigs.k8s.io/container-object-storage-interface/sidecar/pkg/reconciler"
 )
 
+const (
+	// eventRecorderName is the reporting controller for Events recorded by the COSI Sidecar.
+	eventRecorderName = "cosi-sidecar"
+
+	// tracingServiceName is the OpenTelemetry service name for traces from the COSI Sidecar.
+	tracingServiceName = "cosi-sidecar"
+
+	// tracingShutdownTimeout limits how long exiting waits for remaining spans to be exported.
+	tracingShutdownTimeout = 5 * time.Second
+)
+
 var (
 	scheme = runtime.NewScheme()
 	logger = ctrl.Log.WithName("setup")
@@ -57,6 +77,9 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var driverProbeInterval, driverLivenessTimeout time.Duration
+	var rpcLogVerbosity int
+	var tracingConfig cositracing.Config
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -72,6 +95,14 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.DurationVar(&driverProbeInterval, "driver-probe-interval", 10*time.Second,
+		"How often the driver's health is probed. Readiness fails when a probe fails.")
+	flag.DurationVar(&driverLivenessTimeout, "driver-liveness-timeout", 5*time.Minute,
+		"How long the driver may be unhealthy before the liveness check fails.")
+	flag.IntVar(&rpcLogVerbosity, "rpc-log-verbosity", 2,
+		"Log verbosity at which driver RPCs are logged, including request and response bodies. "+
+			"Sensitive fields are redacted.")
+	tracingConfig.BindFlags(flag.CommandLine)
 	opts := zap.Options{
 		Development: true,
 	}
@@ -127,18 +158,33 @@ func main() {
 
 	ctx := ctrl.SetupSignalHandler()
 
+	shutdownTracing, err := cositracing.Setup(ctx, tracingServiceName, tracingConfig)
+	if err != nil {
+		logger.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	rpcEndpoint, ok := os.LookupEnv(objectstoragev1alpha2.RpcEndpointEnvVarName)
 	if !ok {
 		rpcEndpoint = objectstoragev1alpha2.RpcEndpointDefault
 	}
 
 	logger.Info("attempting connection to driver", "endpoint", rpcEndpoint)
-	driverInfo, err := connectRpcAndGetDriverInfo(ctx, rpcEndpoint)
+	driverMetrics := rpcmetrics.New()
+	ctrlmetrics.Registry.MustRegister(driverMetrics)
+	driverInfo, rpcConn, err := connectRpcAndGetDriverInfo(ctx, rpcEndpoint, rpcTLSFilesFromEnv(),
+		grpc.WithChainUnaryInterceptor(
+			rpctrace.UnaryClientInterceptor(),
+			driverMetrics.UnaryClientInterceptor(),
+			rpclog.UnaryClientInterceptor(ctrl.Log.WithName("rpc"), rpcLogVerbosity),
+		),
+	)
 	if err != nil {
 		logger.Error(err, "driver connection error")
 		os.Exit(1)
 	}
 	logger.Info("successfully connected to driver", "name", driverInfo.Name)
+	driverMetrics.SetDriverName(driverInfo.Name)
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
@@ -163,10 +209,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	driverConnection := reconciler.NewDriverConnection(*driverInfo)
+	if err := mgr.Add(newDriverMonitor(rpcConn, driverConnection)); err != nil {
+		logger.Error(err, "unable to set up driver connection monitoring")
+		os.Exit(1)
+	}
+
 	if err := (&reconciler.BucketReconciler{
 		Client:     mgr.GetClient(),
 		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorder(eventRecorderName),
 		DriverInfo: *driverInfo,
+		Connection: driverConnection,
 	}).SetupWithManager(mgr); err != nil {
 		logger.Error(err, "unable to create controller", "controller", "Bucket")
 		os.Exit(1)
@@ -174,24 +228,49 @@ func main() {
 	if err := (&reconciler.BucketAccessReconciler{
 		Client:     mgr.GetClient(),
 		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorder(eventRecorderName),
 		DriverInfo: *driverInfo,
+		Connection: driverConnection,
 	}).SetupWithManager(mgr); err != nil {
 		logger.Error(err, "unable to create controller", "controller", "BucketAccess")
 		os.Exit(1)
 	}
 
+	health := newDriverHealth(rpcConn, cosiproto.NewIdentityClient(rpcConn), driverProbeInterval, driverLivenessTimeout)
+	if err := mgr.Add(health); err != nil {
+		logger.Error(err, "unable to set up driver health probing")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		logger.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
+	if err := mgr.AddHealthzCheck("driver", health.HealthzCheck); err != nil {
+		logger.Error(err, "unable to set up driver health check")
+		os.Exit(1)
+	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		logger.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("driver", health.ReadyzCheck); err != nil {
+		logger.Error(err, "unable to set up driver ready check")
+		os.Exit(1)
+	}
 
 	logger.Info("starting manager")
-	if err := mgr.Start(ctx); err != nil {
-		logger.Error(err, "problem running manager")
+	startErr := mgr.Start(ctx)
+
+	// The manager context is done, so use a new context to flush remaining spans.
+	flushCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Error(err, "failed to shut down tracing")
+	}
+	cancel()
+
+	if startErr != nil {
+		logger.Error(startErr, "problem running manager")
 		os.Exit(1)
 	}
 }
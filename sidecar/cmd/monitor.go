@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/protobuf/proto"
+
+	"sigs.k8s.io/container-object-storage-interface/sidecar/pkg/reconciler"
+)
+
+var (
+	// delay between attempts to get driver info after reconnecting - overrideable for unit tests
+	driverInfoRetryDelay = 5 * time.Second
+	// timeout for getting driver info after reconnecting
+	driverInfoTimeout = 30 * time.Second
+)
+
+// driverMonitor watches the connection to the driver for the lifetime of the Sidecar.
+// While the driver is disconnected, reconciles are paused. When the driver reconnects, it may have
+// restarted with a different configuration, so its info is fetched again. A changed protocol set or
+// capabilities are adopted, but a changed driver name is fatal: all Sidecar watches are filtered
+// by driver name, and resources bound to the old name would be abandoned silently.
+type driverMonitor struct {
+	conn       *grpc.ClientConn
+	connection *reconciler.DriverConnection
+}
+
+func newDriverMonitor(conn *grpc.ClientConn, connection *reconciler.DriverConnection) *driverMonitor {
+	return &driverMonitor{
+		conn:       conn,
+		connection: connection,
+	}
+}
+
+// Start monitors the connection until the context is done. Implements manager.Runnable.
+// Returns an error if the driver reconnects with a different name, which stops the Sidecar.
+func (m *driverMonitor) Start(ctx context.Context) error {
+	disconnected := false
+
+	state := m.conn.GetState()
+	for {
+		switch state {
+		case connectivity.Ready:
+			if disconnected {
+				reconnected, err := m.refreshDriverInfo(ctx)
+				if err != nil {
+					return err
+				}
+				disconnected = !reconnected
+			}
+		case connectivity.Idle:
+			// An idle client doesn't reconnect until an RPC is attempted. Reconnect right away so
+			// that reconciles don't stay paused.
+			disconnected = m.setDisconnected(disconnected, state)
+			m.conn.Connect()
+		default:
+			disconnected = m.setDisconnected(disconnected, state)
+		}
+
+		if !m.conn.WaitForStateChange(ctx, state) {
+			return nil // context done
+		}
+		state = m.conn.GetState()
+		logger.V(1).Info("RPC connection state change", "time", time.Now(), "state", state)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+// All Sidecar replicas must pause when their own driver is disconnected.
+func (m *driverMonitor) NeedLeaderElection() bool {
+	return false
+}
+
+// Pause reconciles if not already paused. Returns true.
+func (m *driverMonitor) setDisconnected(alreadyDisconnected bool, state connectivity.State) bool {
+	if !alreadyDisconnected {
+		logger.Error(nil, "lost connection to driver; pausing reconciles until it reconnects", "state", state)
+		m.connection.SetDisconnected()
+	}
+	return true
+}
+
+// Get the driver's info after reconnecting, and resume reconciles with the updated info.
+// Returns false if the connection was lost again before the info could be retrieved.
+// Returns an error if the driver name has changed.
+func (m *driverMonitor) refreshDriverInfo(ctx context.Context) (bool, error) {
+	var info *reconciler.DriverInfo
+	for {
+		var err error
+		info, err = m.getDriverInfo(ctx)
+		if err == nil {
+			break
+		}
+		logger.Error(err, "failed to get driver info after reconnecting; retrying")
+
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-time.After(driverInfoRetryDelay):
+		}
+		if m.conn.GetState() != connectivity.Ready {
+			return false, nil
+		}
+	}
+
+	previous, _ := m.connection.Info()
+	if info.Name != previous.Name {
+		return false, fmt.Errorf("driver name changed from %q to %q after reconnecting; "+
+			"the Sidecar must be restarted to manage resources for the new driver name", previous.Name, info.Name)
+	}
+
+	if !slices.Equal(info.SupportedProtocols, previous.SupportedProtocols) ||
+		!proto.Equal(info.Capabilities, previous.Capabilities) {
+		logger.Info("driver info changed after reconnecting",
+			"previousProtocols", previous.SupportedProtocols, "protocols", info.SupportedProtocols,
+			"previousCapabilities", previous.Capabilities, "capabilities", info.Capabilities)
+	}
+
+	m.connection.SetConnected(*info)
+	logger.Info("reconnected to driver; resuming reconciles", "name", info.Name)
+	return true, nil
+}
+
+func (m *driverMonitor) getDriverInfo(ctx context.Context) (*reconciler.DriverInfo, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, driverInfoTimeout)
+	defer cancel()
+	return getDriverInfo(timeoutCtx, m.conn)
+}
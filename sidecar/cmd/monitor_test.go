@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+	"sigs.k8s.io/container-object-storage-interface/sidecar/pkg/reconciler"
+)
+
+func Test_driverMonitor(t *testing.T) {
+	ctx := context.Background()
+	grpcConnectDelay = 30 * time.Millisecond
+	driverInfoRetryDelay = 30 * time.Millisecond
+	// ctrl.SetLogger(zap.New(zap.UseDevMode(true))) // uncomment locally to see debug logs
+
+	tmpDir := mkTempD(t)
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+	sockPath := tmpDir + "/cosi.sock"
+	sockUri := "unix://" + sockPath
+
+	// start a driver with the given info, and return a func that stops it
+	startDriver := func(name string, protocols ...cosiproto.ObjectProtocol_Type) func() {
+		identityServer := &fakeIdentityServer{
+			getInfoResponse: &cosiproto.DriverGetInfoResponse{Name: name},
+		}
+		for _, p := range protocols {
+			identityServer.getInfoResponse.SupportedProtocols = append(identityServer.getInfoResponse.SupportedProtocols,
+				&cosiproto.ObjectProtocol{Type: p})
+		}
+		server := grpc.NewServer()
+		cosiproto.RegisterIdentityServer(server, identityServer)
+		go startServer(t, sockPath, server)
+		return server.Stop
+	}
+
+	eventuallyConnected := func(t *testing.T, conn *reconciler.DriverConnection, want bool) {
+		t.Helper()
+		assert.Eventually(t, func() bool {
+			_, connected := conn.Info()
+			return connected == want
+		}, 2*time.Second, 10*time.Millisecond)
+	}
+
+	stopDriver := startDriver("s3.cosi.test", cosiproto.ObjectProtocol_S3)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 150*time.Millisecond)
+	defer cancel()
+	driverInfo, rpcConn, err := connectRpcAndGetDriverInfo(timeoutCtx, sockUri, rpcTLSFiles{})
+	require.NoError(t, err)
+	defer func() { _ = rpcConn.Close() }()
+
+	driverConnection := reconciler.NewDriverConnection(*driverInfo)
+	monitorCtx, stopMonitor := context.WithCancel(ctx)
+	defer stopMonitor()
+	monitorErr := make(chan error)
+	go func() { monitorErr <- newDriverMonitor(rpcConn, driverConnection).Start(monitorCtx) }()
+
+	t.Run("driver restarts with same info", func(t *testing.T) {
+		stopDriver()
+		eventuallyConnected(t, driverConnection, false)
+
+		stopDriver = startDriver("s3.cosi.test", cosiproto.ObjectProtocol_S3)
+		eventuallyConnected(t, driverConnection, true)
+
+		info, _ := driverConnection.Info()
+		assert.Equal(t, []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3}, info.SupportedProtocols)
+	})
+
+	t.Run("driver restarts with different protocols", func(t *testing.T) {
+		stopDriver()
+		eventuallyConnected(t, driverConnection, false)
+
+		stopDriver = startDriver("s3.cosi.test", cosiproto.ObjectProtocol_S3, cosiproto.ObjectProtocol_AZURE)
+		eventuallyConnected(t, driverConnection, true)
+
+		info, _ := driverConnection.Info()
+		assert.Equal(t, "s3.cosi.test", info.Name)
+		assert.Equal(t,
+			[]cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3, cosiproto.ObjectProtocol_AZURE},
+			info.SupportedProtocols)
+	})
+
+	t.Run("driver restarts with different name", func(t *testing.T) {
+		stopDriver()
+		eventuallyConnected(t, driverConnection, false)
+
+		stopDriver = startDriver("other.cosi.test", cosiproto.ObjectProtocol_S3)
+		defer stopDriver()
+
+		select {
+		case err := <-monitorErr:
+			assert.ErrorContains(t, err, `driver name changed from "s3.cosi.test" to "other.cosi.test"`)
+		case <-time.After(2 * time.Second):
+			assert.Fail(t, "monitor did not stop after driver name changed")
+		}
+
+		_, connected := driverConnection.Info()
+		assert.False(t, connected)
+	})
+}
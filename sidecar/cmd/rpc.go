@@ -19,12 +19,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
 	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
@@ -34,27 +36,57 @@ import (
 var (
 	// max connection backoff delay - overrideable to speed up unit tests
 	grpcConnectDelay = 1 * time.Second
+	// max connection backoff delay for network endpoints, which may be shared by many Sidecars
+	grpcNetworkMaxConnectDelay = 30 * time.Second
 )
 
-func connectRpcAndGetDriverInfo(ctx context.Context, rpcEndpoint string) (*reconciler.DriverInfo, error) {
-	if !strings.HasPrefix(rpcEndpoint, "unix://") {
-		return nil, fmt.Errorf("rpc endpoint must be a unix socket prefix 'unix://': %s", rpcEndpoint)
+// Connect to the driver's RPC endpoint, and get the driver's info.
+// Unix socket endpoints are unencrypted. Network endpoints require mutual TLS using tlsFiles.
+// Additional dial options (e.g., interceptors) are applied to the connection.
+func connectRpcAndGetDriverInfo(
+	ctx context.Context, rpcEndpoint string, tlsFiles rpcTLSFiles, dialOpts ...grpc.DialOption,
+) (*reconciler.DriverInfo, *grpc.ClientConn, error) {
+	target, isNetwork, err := parseRpcEndpoint(rpcEndpoint)
+	if err != nil {
+		return nil, nil, err
 	}
-	if !strings.HasSuffix(rpcEndpoint, ".sock") {
-		return nil, fmt.Errorf("rpc endpoint must be a unix socket with extension '.sock': %s", rpcEndpoint)
+
+	var creds credentials.TransportCredentials
+	maxConnectDelay := grpcConnectDelay // no need to backoff when not connected over network
+	if isNetwork {
+		creds, err = newMutualTLSCredentials(ctx, tlsFiles)
+		if err != nil {
+			return nil, nil, fmt.Errorf("network rpc endpoint %q requires mutual TLS: %w", rpcEndpoint, err)
+		}
+		maxConnectDelay = grpcNetworkMaxConnectDelay
+	} else {
+		if tlsFiles.isSet() {
+			return nil, nil, fmt.Errorf("TLS is not supported for unix socket rpc endpoint: %s", rpcEndpoint)
+		}
+		creds = insecure.NewCredentials() // no TLS because restricted to unix sockets
 	}
 
 	// establish a timeout for RPC connection and driver info retrieval
 	timeoutCtx, timeoutCancel := context.WithTimeout(ctx, 120*time.Second)
 	defer timeoutCancel()
 
-	rpcConn, err := connectRpc(timeoutCtx, rpcEndpoint)
+	rpcConn, err := connectRpc(timeoutCtx, target, creds, maxConnectDelay, dialOpts...)
 	if err != nil {
-		return nil, fmt.Errorf("unable to connect to RPC endpoint %q: %w", rpcEndpoint, err)
+		return nil, nil, fmt.Errorf("unable to connect to RPC endpoint %q: %w", rpcEndpoint, err)
 	}
 
+	validatedInfo, err := getDriverInfo(timeoutCtx, rpcConn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validatedInfo, rpcConn, nil
+}
+
+// Get and validate the driver's info over an established connection.
+func getDriverInfo(ctx context.Context, rpcConn *grpc.ClientConn) (*reconciler.DriverInfo, error) {
 	client := cosiproto.NewIdentityClient(rpcConn)
-	driverResponse, err := client.DriverGetInfo(timeoutCtx, &cosiproto.DriverGetInfoRequest{})
+	driverResponse, err := client.DriverGetInfo(ctx, &cosiproto.DriverGetInfoRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("unable to get driver info: %w", err)
 	}
@@ -67,25 +99,60 @@ func connectRpcAndGetDriverInfo(ctx context.Context, rpcEndpoint string) (*recon
 	return validatedInfo, nil
 }
 
-func connectRpc(timeoutCtx context.Context, rpcEndpoint string) (*grpc.ClientConn, error) {
+// Parse the RPC endpoint into a gRPC target, and determine whether it is a network endpoint.
+func parseRpcEndpoint(rpcEndpoint string) (target string, isNetwork bool, err error) {
+	switch {
+	case strings.HasPrefix(rpcEndpoint, "unix://"):
+		if !strings.HasSuffix(rpcEndpoint, ".sock") {
+			return "", false, fmt.Errorf("rpc endpoint must be a unix socket with extension '.sock': %s", rpcEndpoint)
+		}
+		return rpcEndpoint, false, nil
+
+	case strings.HasPrefix(rpcEndpoint, "dns:"):
+		// gRPC's DNS resolver handles the rest of the parsing, including the optional DNS authority
+		if strings.TrimLeft(strings.TrimPrefix(rpcEndpoint, "dns:"), "/") == "" {
+			return "", false, fmt.Errorf("rpc endpoint must specify a host: %s", rpcEndpoint)
+		}
+		return rpcEndpoint, true, nil
+
+	case strings.HasPrefix(rpcEndpoint, "tcp://"):
+		hostPort := strings.TrimPrefix(rpcEndpoint, "tcp://")
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil || host == "" || port == "" {
+			return "", false, fmt.Errorf("rpc endpoint must be of the form 'tcp://<host>:<port>': %s", rpcEndpoint)
+		}
+		// connect to the address directly, without name resolution or load balancing
+		return "passthrough:///" + hostPort, true, nil
+
+	default:
+		return "", false, fmt.Errorf("rpc endpoint must be a unix socket prefix 'unix://', "+
+			"or a network endpoint with prefix 'dns:///' or 'tcp://': %s", rpcEndpoint)
+	}
+}
+
+func connectRpc(
+	timeoutCtx context.Context,
+	target string,
+	creds credentials.TransportCredentials,
+	maxConnectDelay time.Duration,
+	dialOpts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
 	bc := backoff.DefaultConfig
-	bc.BaseDelay = grpcConnectDelay
-	bc.MaxDelay = grpcConnectDelay // no need to backoff because not connected over network
+	bc.BaseDelay = min(grpcConnectDelay, maxConnectDelay)
+	bc.MaxDelay = maxConnectDelay
 	dialOptions := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()), // no TLS because restricted to unix sockets
-		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bc}),  // retry every second after failure
-		grpc.WithIdleTimeout(time.Duration(0)),                   // never close connection because of inactivity
+		grpc.WithTransportCredentials(creds),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: bc}), // retry after failure
+		grpc.WithIdleTimeout(time.Duration(0)),                  // never close connection because of inactivity
 	}
+	dialOptions = append(dialOptions, dialOpts...)
 
-	conn, err := grpc.NewClient(rpcEndpoint, dialOptions...)
+	conn, err := grpc.NewClient(target, dialOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create gRPC client: %w", err)
 	}
 
-	// TODO: do we need WithContextDialer() to handle reconnects if/when the driver
-	//   (gRPC server) pod crashes and restarts? (if so don't use timeoutCtx)
-	// TODO: add interceptor that logs gRPC calls?
-	// TODO: add metrics to gRPC calls?
+	// The client reconnects automatically if the driver restarts. See driverMonitor.
 	// TODO: could possibly consume CSI lib for all/some of the above, but needs investigation
 	//   ref: https://github.com/kubernetes-csi/csi-lib-utils/blob/master/connection/connection.go
 
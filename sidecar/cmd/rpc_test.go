@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
 
 	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
 	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
@@ -39,13 +40,13 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 	// ctrl.SetLogger(zap.New(zap.UseDevMode(true))) // uncomment locally to see debug logs
 
 	t.Run("socket not unix protocol", func(t *testing.T) {
-		conn, err := connectRpcAndGetDriverInfo(ctx, "/some/dir/cosi.sock")
+		conn, _, err := connectRpcAndGetDriverInfo(ctx, "/some/dir/cosi.sock", rpcTLSFiles{})
 		assert.Error(t, err)
 		assert.Nil(t, conn)
 	})
 
 	t.Run("no .sock extension", func(t *testing.T) {
-		conn, err := connectRpcAndGetDriverInfo(ctx, "unix:///some/dir/cosi.soc")
+		conn, _, err := connectRpcAndGetDriverInfo(ctx, "unix:///some/dir/cosi.soc", rpcTLSFiles{})
 		assert.Error(t, err)
 		assert.Nil(t, conn)
 	})
@@ -69,7 +70,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.NoError(t, err)
 		assert.Equal(t, "s3.cosi.mydriver.net", driverInfo.Name)
 	})
@@ -93,7 +94,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.NoError(t, err)
 		assert.Equal(t, "azure.cosi.mydriver.net", driverInfo.Name)
 	})
@@ -117,7 +118,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.NoError(t, err)
 		assert.Equal(t, "gcs.cosi.mydriver.net", driverInfo.Name)
 	})
@@ -141,7 +142,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.ErrorContains(t, err, "fake error")
 		assert.Nil(t, driverInfo)
 	})
@@ -166,7 +167,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.ErrorContains(t, err, "unable to get driver info")
 		assert.Nil(t, driverInfo)
 	})
@@ -190,7 +191,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		go serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.ErrorContains(t, err, "driver info is invalid")
 		assert.Nil(t, driverInfo)
 	})
@@ -204,7 +205,7 @@ func Test_connectRpcAndGetDriverInfo(t *testing.T) {
 		require.NoError(t, err)
 		// do not call serve()
 
-		driverInfo, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, tmpSockUri, rpcTLSFiles{})
 		assert.ErrorContains(t, err, "timed out waiting for RPC client to connect")
 		assert.Nil(t, driverInfo)
 	})
@@ -228,7 +229,7 @@ func Test_connectRpc(t *testing.T) {
 		go startServer(t, sockPath, server)
 		defer server.Stop()
 
-		conn, err := connectRpc(timeoutCtx, sockUri)
+		conn, err := connectRpc(timeoutCtx, sockUri, insecure.NewCredentials(), grpcConnectDelay)
 		assert.NoError(t, err)
 		state := conn.GetState()
 		assert.Equal(t, connectivity.Ready, state)
@@ -250,7 +251,7 @@ func Test_connectRpc(t *testing.T) {
 		}()
 		defer server.Stop()
 
-		conn, err := connectRpc(timeoutCtx, sockUri)
+		conn, err := connectRpc(timeoutCtx, sockUri, insecure.NewCredentials(), grpcConnectDelay)
 		assert.NoError(t, err)
 		state := conn.GetState()
 		assert.Equal(t, connectivity.Ready, state)
@@ -265,7 +266,7 @@ func Test_connectRpc(t *testing.T) {
 		sockPath := tmpDir + "/cosi.sock"
 		sockUri := "unix://" + sockPath
 
-		conn, err := connectRpc(timeoutCtx, sockUri)
+		conn, err := connectRpc(timeoutCtx, sockUri, insecure.NewCredentials(), grpcConnectDelay)
 		assert.ErrorContains(t, err, "timed out waiting for RPC client to connect")
 		assert.Nil(t, conn)
 	})
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+
+	objectstoragev1alpha2 "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+)
+
+// Files used to configure mutual TLS with a network RPC endpoint.
+type rpcTLSFiles struct {
+	CAFile   string
+	CertFile string
+	KeyFile  string
+}
+
+// Get the RPC TLS file locations from the environment.
+func rpcTLSFilesFromEnv() rpcTLSFiles {
+	return rpcTLSFiles{
+		CAFile:   os.Getenv(objectstoragev1alpha2.RpcTLSCAFileEnvVarName),
+		CertFile: os.Getenv(objectstoragev1alpha2.RpcTLSCertFileEnvVarName),
+		KeyFile:  os.Getenv(objectstoragev1alpha2.RpcTLSKeyFileEnvVarName),
+	}
+}
+
+// Returns true if any file is configured.
+func (f rpcTLSFiles) isSet() bool {
+	return f.CAFile != "" || f.CertFile != "" || f.KeyFile != ""
+}
+
+// Validate that all files are configured.
+func (f rpcTLSFiles) validate() error {
+	missing := []string{}
+	if f.CAFile == "" {
+		missing = append(missing, objectstoragev1alpha2.RpcTLSCAFileEnvVarName)
+	}
+	if f.CertFile == "" {
+		missing = append(missing, objectstoragev1alpha2.RpcTLSCertFileEnvVarName)
+	}
+	if f.KeyFile == "" {
+		missing = append(missing, objectstoragev1alpha2.RpcTLSKeyFileEnvVarName)
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("mutual TLS configuration is incomplete: missing %v", missing)
+	}
+	return nil
+}
+
+// Create mutual TLS transport credentials from the given files. The client certificate, key, and CA
+// bundle are reloaded when they change on disk (e.g., when cert-manager renews a Secret), so that
+// new connections use the current files without restarting the Sidecar. The certificate watcher
+// runs until the context is done.
+func newMutualTLSCredentials(ctx context.Context, files rpcTLSFiles) (credentials.TransportCredentials, error) {
+	if err := files.validate(); err != nil {
+		return nil, err
+	}
+
+	certWatcher, err := certwatcher.New(files.CertFile, files.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load client certificate: %w", err)
+	}
+	go func() {
+		if err := certWatcher.Start(ctx); err != nil {
+			logger.Error(err, "client certificate watcher stopped")
+		}
+	}()
+
+	caPool := &reloadingCAPool{path: files.CAFile}
+	if _, err := caPool.get(); err != nil {
+		return nil, err
+	}
+
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certWatcher.GetCertificate(nil)
+		},
+	}
+	return &reloadingTLSCredentials{
+		TransportCredentials: credentials.NewTLS(base),
+		base:                 base,
+		caPool:               caPool,
+	}, nil
+}
+
+// TLS client credentials that use the current CA bundle for each new connection.
+// gRPC's TLS credentials copy the tls.Config when created, so the root CAs can't be updated in place.
+type reloadingTLSCredentials struct {
+	credentials.TransportCredentials // TLS credentials using base config, for non-handshake methods
+
+	base   *tls.Config
+	caPool *reloadingCAPool
+}
+
+// ClientHandshake performs the TLS handshake using the current CA bundle.
+func (c *reloadingTLSCredentials) ClientHandshake(
+	ctx context.Context, authority string, rawConn net.Conn,
+) (net.Conn, credentials.AuthInfo, error) {
+	pool, err := c.caPool.get()
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := c.base.Clone()
+	cfg.RootCAs = pool
+	return credentials.NewTLS(cfg).ClientHandshake(ctx, authority, rawConn)
+}
+
+// Clone returns a copy of the credentials that shares the same reloading files.
+func (c *reloadingTLSCredentials) Clone() credentials.TransportCredentials {
+	return &reloadingTLSCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		base:                 c.base.Clone(),
+		caPool:               c.caPool,
+	}
+}
+
+// CA certificate pool loaded from a file, and reloaded whenever the file's modification time changes.
+// Handshakes are infrequent, so checking the file on each use is cheap enough.
+type reloadingCAPool struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	pool    *x509.CertPool
+}
+
+// Get the current CA pool. If the file can't be reloaded, the last good pool is used.
+func (p *reloadingCAPool) get() (*x509.CertPool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pool, modTime, err := p.load()
+	if err != nil {
+		if p.pool != nil {
+			logger.Error(err, "failed to reload CA bundle; using previously loaded CA bundle", "path", p.path)
+			return p.pool, nil
+		}
+		return nil, err
+	}
+	if pool != nil {
+		if p.pool != nil {
+			logger.Info("reloaded CA bundle", "path", p.path)
+		}
+		p.pool = pool
+		p.modTime = modTime
+	}
+	return p.pool, nil
+}
+
+// Load the CA pool if the file has changed since it was last loaded. Returns a nil pool if unchanged.
+func (p *reloadingCAPool) load() (*x509.CertPool, time.Time, error) {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to read CA bundle: %w", err)
+	}
+	if p.pool != nil && info.ModTime().Equal(p.modTime) {
+		return nil, p.modTime, nil
+	}
+
+	caPEM, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to read CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, time.Time{}, fmt.Errorf("CA bundle %q contains no valid PEM certificates", p.path)
+	}
+	return pool, info.ModTime(), nil
+}
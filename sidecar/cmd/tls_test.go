@@ -0,0 +1,281 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+func Test_parseRpcEndpoint(t *testing.T) {
+	tests := []struct {
+		endpoint    string
+		wantTarget  string
+		wantNetwork bool
+		wantErr     bool
+	}{
+		{"unix:///var/lib/cosi/cosi.sock", "unix:///var/lib/cosi/cosi.sock", false, false},
+		{"unix:///var/lib/cosi/cosi.soc", "", false, true},
+		{"/var/lib/cosi/cosi.sock", "", false, true},
+		{"dns:///driver.cosi.svc:9000", "dns:///driver.cosi.svc:9000", true, false},
+		{"dns://8.8.8.8/driver.example.com:9000", "dns://8.8.8.8/driver.example.com:9000", true, false},
+		{"dns:///", "", false, true},
+		{"tcp://10.0.0.1:9000", "passthrough:///10.0.0.1:9000", true, false},
+		{"tcp://driver.example.com:9000", "passthrough:///driver.example.com:9000", true, false},
+		{"tcp://10.0.0.1", "", false, true},
+		{"tcp://:9000", "", false, true},
+		{"http://driver.example.com:9000", "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			target, isNetwork, err := parseRpcEndpoint(tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTarget, target)
+			assert.Equal(t, tt.wantNetwork, isNetwork)
+		})
+	}
+}
+
+func Test_connectRpcAndGetDriverInfo_mutualTLS(t *testing.T) {
+	ctx := context.Background()
+	grpcConnectDelay = 30 * time.Millisecond
+	grpcNetworkMaxConnectDelay = 30 * time.Millisecond
+
+	tmpDir := mkTempD(t)
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	serverCA := newTestCA(t)
+	clientCA := newTestCA(t)
+	otherCA := newTestCA(t)
+
+	// client trusts the server CA, and presents a cert signed by the client CA
+	clientFiles := rpcTLSFiles{
+		CAFile:   writeTestFile(t, tmpDir, "ca.crt", serverCA.certPEM),
+		CertFile: writeTestFile(t, tmpDir, "tls.crt", nil),
+		KeyFile:  writeTestFile(t, tmpDir, "tls.key", nil),
+	}
+	clientCA.issue(t, "cosi-sidecar", clientFiles.CertFile, clientFiles.KeyFile)
+
+	// server trusts the client CA, and presents a cert for localhost signed by the server CA
+	serverCertFile := filepath.Join(tmpDir, "server.crt")
+	serverKeyFile := filepath.Join(tmpDir, "server.key")
+	serverCA.issue(t, "localhost", serverCertFile, serverKeyFile)
+	serverCert, err := tls.LoadX509KeyPair(serverCertFile, serverKeyFile)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(clientCA.certPEM))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	cosiproto.RegisterIdentityServer(server, &fakeIdentityServer{
+		getInfoResponse: &cosiproto.DriverGetInfoResponse{
+			Name:               "s3.cosi.mydriver.net",
+			SupportedProtocols: []*cosiproto.ObjectProtocol{{Type: cosiproto.ObjectProtocol_S3}},
+		},
+	})
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	for _, endpoint := range []string{
+		"tcp://127.0.0.1:" + strconv.Itoa(port),
+		"dns:///localhost:" + strconv.Itoa(port),
+	} {
+		t.Run(endpoint, func(t *testing.T) {
+			timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			defer cancel()
+
+			driverInfo, conn, err := connectRpcAndGetDriverInfo(timeoutCtx, endpoint, clientFiles)
+			require.NoError(t, err)
+			defer func() { _ = conn.Close() }()
+			assert.Equal(t, "s3.cosi.mydriver.net", driverInfo.Name)
+		})
+	}
+
+	t.Run("untrusted server", func(t *testing.T) {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+		defer cancel()
+
+		files := clientFiles
+		files.CAFile = writeTestFile(t, tmpDir, "other-ca.crt", otherCA.certPEM)
+		driverInfo, _, err := connectRpcAndGetDriverInfo(timeoutCtx, "tcp://127.0.0.1:"+strconv.Itoa(port), files)
+		assert.ErrorContains(t, err, "timed out waiting for RPC client to connect")
+		assert.Nil(t, driverInfo)
+	})
+
+	t.Run("network endpoint without TLS", func(t *testing.T) {
+		driverInfo, _, err := connectRpcAndGetDriverInfo(ctx, "tcp://127.0.0.1:"+strconv.Itoa(port), rpcTLSFiles{})
+		assert.ErrorContains(t, err, "requires mutual TLS")
+		assert.Nil(t, driverInfo)
+	})
+
+	t.Run("network endpoint with incomplete TLS", func(t *testing.T) {
+		files := clientFiles
+		files.KeyFile = ""
+		driverInfo, _, err := connectRpcAndGetDriverInfo(ctx, "tcp://127.0.0.1:"+strconv.Itoa(port), files)
+		assert.ErrorContains(t, err, "COSI_ENDPOINT_TLS_KEY_FILE")
+		assert.Nil(t, driverInfo)
+	})
+
+	t.Run("unix endpoint with TLS", func(t *testing.T) {
+		driverInfo, _, err := connectRpcAndGetDriverInfo(ctx, "unix:///some/dir/cosi.sock", clientFiles)
+		assert.ErrorContains(t, err, "TLS is not supported")
+		assert.Nil(t, driverInfo)
+	})
+}
+
+func Test_reloadingCAPool(t *testing.T) {
+	tmpDir := mkTempD(t)
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	caA := newTestCA(t)
+	caB := newTestCA(t)
+	certA := caA.issue(t, "localhost", filepath.Join(tmpDir, "a.crt"), filepath.Join(tmpDir, "a.key"))
+	certB := caB.issue(t, "localhost", filepath.Join(tmpDir, "b.crt"), filepath.Join(tmpDir, "b.key"))
+
+	verify := func(pool *x509.CertPool, cert *x509.Certificate) error {
+		_, err := cert.Verify(x509.VerifyOptions{Roots: pool, DNSName: "localhost"})
+		return err
+	}
+
+	caFile := writeTestFile(t, tmpDir, "ca.crt", caA.certPEM)
+	p := &reloadingCAPool{path: caFile}
+
+	pool, err := p.get()
+	require.NoError(t, err)
+	assert.NoError(t, verify(pool, certA))
+	assert.Error(t, verify(pool, certB))
+
+	// rotated CA bundle is picked up
+	writeTestFile(t, tmpDir, "ca.crt", caB.certPEM)
+	require.NoError(t, os.Chtimes(caFile, time.Now(), time.Now().Add(time.Minute)))
+	pool, err = p.get()
+	require.NoError(t, err)
+	assert.Error(t, verify(pool, certA))
+	assert.NoError(t, verify(pool, certB))
+
+	// invalid CA bundle is ignored in favor of the last good one
+	writeTestFile(t, tmpDir, "ca.crt", []byte("not a cert"))
+	require.NoError(t, os.Chtimes(caFile, time.Now(), time.Now().Add(2*time.Minute)))
+	pool, err = p.get()
+	require.NoError(t, err)
+	assert.NoError(t, verify(pool, certB))
+
+	// initial load fails without a good CA bundle
+	_, err = (&reloadingCAPool{path: caFile}).get()
+	assert.ErrorContains(t, err, "no valid PEM certificates")
+}
+
+type testCA struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+}
+
+// test helper to create a self-signed CA
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cosi-test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// test helper to issue a client+server cert for the given name, and write it and its key to files
+func (ca *testCA) issue(t *testing.T, name, certFile, keyFile string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+// test helper to write a file in the given dir, returning its path
+func writeTestFile(t *testing.T, dir, name string, content []byte) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	return path
+}
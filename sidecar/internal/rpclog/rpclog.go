@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rpclog logs COSI driver RPCs without exposing sensitive information.
+package rpclog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+// Redacted replaces the value of sensitive fields in logged messages.
+const Redacted = "***redacted***"
+
+// UnaryClientInterceptor returns a gRPC client interceptor that logs the method, duration, status
+// code, and request and response bodies of each RPC at the given verbosity.
+// Fields marked with the `cosi_secret` option are redacted from logged bodies.
+func UnaryClientInterceptor(logger logr.Logger, verbosity int) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		log := logger.V(verbosity)
+		if !log.Enabled() {
+			return err
+		}
+		keysAndValues := []any{
+			"method", method,
+			"duration", time.Since(start),
+			"code", status.Code(err).String(),
+			"request", Format(req),
+		}
+		if err != nil {
+			keysAndValues = append(keysAndValues, "error", status.Convert(err).Message())
+		} else {
+			keysAndValues = append(keysAndValues, "response", Format(reply))
+		}
+		log.Info("driver RPC", keysAndValues...)
+
+		return err
+	}
+}
+
+// Format returns the JSON representation of a protobuf message with sensitive fields redacted.
+func Format(msg any) string {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return fmt.Sprintf("%T", msg)
+	}
+	b, err := protojson.Marshal(Redact(m))
+	if err != nil {
+		return fmt.Sprintf("<%T: %v>", msg, err)
+	}
+	return string(b)
+}
+
+// Redact returns a copy of the message in which the values of all fields marked with the
+// `cosi_secret` option are redacted. All values nested within a sensitive message are redacted as
+// well, but the message structure is preserved to aid debugging. String values are replaced with
+// Redacted, and other values are cleared.
+func Redact(msg proto.Message) proto.Message {
+	if msg == nil {
+		return nil
+	}
+	out := proto.Clone(msg)
+	redactMessage(out.ProtoReflect(), false)
+	return out
+}
+
+func redactMessage(m protoreflect.Message, secret bool) {
+	// collect populated fields first, since a message may not be modified while ranging over it
+	fields := []protoreflect.FieldDescriptor{}
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		fieldSecret := secret || isSecret(fd)
+		switch {
+		case fd.IsList():
+			redactList(m, fd, fieldSecret)
+		case fd.IsMap():
+			redactMap(m, fd, fieldSecret)
+		case fd.Message() != nil:
+			redactMessage(m.Mutable(fd).Message(), fieldSecret)
+		case fieldSecret:
+			if v, ok := redactedScalar(fd); ok {
+				m.Set(fd, v)
+			} else {
+				m.Clear(fd)
+			}
+		}
+	}
+}
+
+func redactList(m protoreflect.Message, fd protoreflect.FieldDescriptor, secret bool) {
+	list := m.Mutable(fd).List()
+	if fd.Message() != nil {
+		for i := range list.Len() {
+			redactMessage(list.Get(i).Message(), secret)
+		}
+		return
+	}
+	if !secret {
+		return
+	}
+	v, ok := redactedScalar(fd)
+	if !ok {
+		m.Clear(fd)
+		return
+	}
+	for i := range list.Len() {
+		list.Set(i, v)
+	}
+}
+
+func redactMap(m protoreflect.Message, fd protoreflect.FieldDescriptor, secret bool) {
+	mp := m.Mutable(fd).Map()
+	valueFd := fd.MapValue()
+	if valueFd.Message() != nil {
+		mp.Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+			redactMessage(v.Message(), secret)
+			return true
+		})
+		return
+	}
+	if !secret {
+		return
+	}
+	v, ok := redactedScalar(valueFd)
+	if !ok {
+		m.Clear(fd)
+		return
+	}
+	keys := []protoreflect.MapKey{}
+	mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	for _, k := range keys {
+		mp.Set(k, v)
+	}
+}
+
+// Get the redacted value for a scalar field. Returns false if the value can't be redacted in place.
+func redactedScalar(fd protoreflect.FieldDescriptor) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(Redacted), true
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(Redacted)), true
+	default:
+		return protoreflect.Value{}, false
+	}
+}
+
+// Determine whether a field is marked with the `cosi_secret` option.
+func isSecret(fd protoreflect.FieldDescriptor) bool {
+	opts := fd.Options()
+	if opts == nil {
+		return false
+	}
+	secret, ok := proto.GetExtension(opts, cosiproto.E_CosiSecret).(bool)
+	return ok && secret
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpclog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+func TestRedact(t *testing.T) {
+	grantResponse := &cosiproto.DriverGrantBucketAccessResponse{
+		AccountId: "account-1",
+		Buckets: []*cosiproto.DriverGrantBucketAccessResponse_BucketInfo{
+			{
+				BucketId: "bucket-1",
+				BucketInfo: &cosiproto.ObjectProtocolAndBucketInfo{
+					S3: &cosiproto.S3BucketInfo{
+						BucketId:        "corp-bucket-1",
+						Endpoint:        "s3.corp.net",
+						Region:          "us-east-1",
+						AddressingStyle: &cosiproto.S3AddressingStyle{Style: cosiproto.S3AddressingStyle_PATH},
+					},
+				},
+			},
+		},
+		Credentials: &cosiproto.CredentialInfo{
+			S3: &cosiproto.S3CredentialInfo{
+				AccessKeyId:     "AKIAEXAMPLE",
+				AccessSecretKey: "secretkey",
+			},
+			Azure: &cosiproto.AzureCredentialInfo{
+				AccessToken:     "sastoken",
+				ExpiryTimestamp: "2025-01-01T00:00:00Z",
+			},
+			Gcs: &cosiproto.GcsCredentialInfo{
+				AccessId:       "hmacid",
+				AccessSecret:   "hmacsecret",
+				PrivateKeyName: "keyname",
+				ServiceAccount: "sa@corp.iam",
+			},
+		},
+	}
+	original := proto.Clone(grantResponse)
+
+	redacted := Redact(grantResponse).(*cosiproto.DriverGrantBucketAccessResponse)
+
+	// input is not modified
+	assert.True(t, proto.Equal(original, grantResponse))
+
+	// non-sensitive fields are kept
+	assert.Equal(t, "account-1", redacted.AccountId)
+	require.Len(t, redacted.Buckets, 1)
+	assert.Equal(t, "bucket-1", redacted.Buckets[0].BucketId)
+
+	// bucket info is sensitive
+	s3Info := redacted.Buckets[0].BucketInfo.S3
+	assert.Equal(t, Redacted, s3Info.BucketId)
+	assert.Equal(t, Redacted, s3Info.Endpoint)
+	assert.Equal(t, Redacted, s3Info.Region)
+	assert.Equal(t, cosiproto.S3AddressingStyle_UNKNOWN, s3Info.AddressingStyle.Style)
+
+	// all credentials are sensitive
+	creds := redacted.Credentials
+	assert.Equal(t, Redacted, creds.S3.AccessKeyId)
+	assert.Equal(t, Redacted, creds.S3.AccessSecretKey)
+	assert.Equal(t, Redacted, creds.Azure.AccessToken)
+	assert.Equal(t, Redacted, creds.Azure.ExpiryTimestamp)
+	assert.Equal(t, Redacted, creds.Gcs.AccessId)
+	assert.Equal(t, Redacted, creds.Gcs.AccessSecret)
+	assert.Equal(t, Redacted, creds.Gcs.PrivateKeyName)
+	assert.Equal(t, Redacted, creds.Gcs.ServiceAccount)
+
+	formatted := Format(grantResponse)
+	for _, secret := range []string{
+		"corp-bucket-1", "s3.corp.net", "AKIAEXAMPLE", "secretkey", "sastoken", "hmacid", "hmacsecret", "keyname",
+	} {
+		assert.NotContains(t, formatted, secret)
+	}
+	assert.Contains(t, formatted, "account-1")
+
+	// requests without sensitive fields are unchanged
+	request := &cosiproto.DriverCreateBucketRequest{
+		Name:       "bc-qwerty",
+		Parameters: map[string]string{"maxSize": "10Gi"},
+	}
+	assert.True(t, proto.Equal(request, Redact(request)))
+
+	assert.Nil(t, Redact(nil))
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	rotateResponse := &cosiproto.DriverRotateBucketAccessCredentialsResponse{
+		Credentials: &cosiproto.CredentialInfo{
+			S3: &cosiproto.S3CredentialInfo{AccessKeyId: "AKIAEXAMPLE", AccessSecretKey: "secretkey"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		verbosity  int
+		invokeErr  error
+		wantLogged []string
+	}{
+		{"success", 1, nil,
+			[]string{`"method"="` + cosiproto.Provisioner_DriverRotateBucketAccessCredentials_FullMethodName + `"`, `"code"="OK"`,
+				`"request"="{\"accountId\":\"account-1\"}"`, Redacted},
+		},
+		{"error", 1, status.Error(codes.InvalidArgument, "bad account"),
+			[]string{`"code"="InvalidArgument"`, `"error"="bad account"`, `"request"=`},
+		},
+		{"verbosity not enabled", 3, nil,
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logged := []string{}
+			logger := funcr.New(func(prefix, args string) {
+				logged = append(logged, args)
+			}, funcr.Options{Verbosity: 2})
+
+			interceptor := UnaryClientInterceptor(logger, tt.verbosity)
+			invoker := func(_ context.Context, _ string, _, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				if tt.invokeErr != nil {
+					return tt.invokeErr
+				}
+				proto.Merge(reply.(proto.Message), rotateResponse)
+				return nil
+			}
+
+			reply := &cosiproto.DriverRotateBucketAccessCredentialsResponse{}
+			err := interceptor(context.Background(),
+				cosiproto.Provisioner_DriverRotateBucketAccessCredentials_FullMethodName,
+				&cosiproto.DriverRotateBucketAccessCredentialsRequest{AccountId: "account-1"},
+				reply, nil, invoker,
+			)
+			assert.ErrorIs(t, err, tt.invokeErr)
+
+			if len(tt.wantLogged) == 0 {
+				assert.Empty(t, logged)
+				return
+			}
+			require.Len(t, logged, 1)
+			for _, want := range tt.wantLogged {
+				assert.Contains(t, logged[0], want)
+			}
+			assert.NotContains(t, logged[0], "secretkey")
+			assert.NotContains(t, logged[0], "AKIAEXAMPLE")
+			if tt.invokeErr == nil {
+				// caller receives the unredacted response
+				assert.Equal(t, "secretkey", reply.Credentials.S3.AccessSecretKey)
+			}
+		})
+	}
+
+	// discarded logger never formats messages
+	interceptor := UnaryClientInterceptor(logr.Discard(), 0)
+	err := interceptor(context.Background(), "/method", nil, nil, nil,
+		func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error { return nil })
+	assert.NoError(t, err)
+	assert.Equal(t, "<nil>", Format(nil))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rpcmetrics records Prometheus metrics for COSI driver RPCs.
+package rpcmetrics
+
+import (
+	"context"
+	"path"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// RequestsTotalName is the name of the counter of completed driver RPCs.
+	RequestsTotalName = "cosi_driver_rpc_requests_total"
+
+	// DurationSecondsName is the name of the histogram of driver RPC latencies.
+	DurationSecondsName = "cosi_driver_rpc_duration_seconds"
+)
+
+// label names for driver RPC metrics
+const (
+	driverLabel = "driver"
+	methodLabel = "method"
+	codeLabel   = "code"
+)
+
+// Metrics records the count and latency of driver RPCs, labeled by driver name, RPC method name
+// (e.g., "DriverCreateBucket"), and gRPC status code. Implements prometheus.Collector.
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	// driver name used for labels, empty until set
+	driverName atomic.Pointer[string]
+}
+
+// New returns driver RPC metrics. The caller must register them with a Prometheus registry.
+func New() *Metrics {
+	labels := []string{driverLabel, methodLabel, codeLabel}
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: RequestsTotalName,
+			Help: "Total number of completed COSI driver RPCs.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: DurationSecondsName,
+			Help: "Latency of COSI driver RPCs in seconds.",
+			// Provisioning calls can take much longer than typical API calls.
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		}, labels),
+	}
+}
+
+// SetDriverName sets the driver name used to label subsequent RPCs. The driver name isn't known
+// until the driver's info is retrieved, so RPCs before this is called have an empty driver label.
+func (m *Metrics) SetDriverName(name string) {
+	m.driverName.Store(&name)
+}
+
+// UnaryClientInterceptor returns a gRPC client interceptor that records metrics for each RPC.
+func (m *Metrics) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		m.observe(method, err, time.Since(start))
+		return err
+	}
+}
+
+func (m *Metrics) observe(fullMethod string, err error, duration time.Duration) {
+	driver := ""
+	if name := m.driverName.Load(); name != nil {
+		driver = *name
+	}
+	labels := prometheus.Labels{
+		driverLabel: driver,
+		methodLabel: path.Base(fullMethod), // e.g., "/sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverCreateBucket"
+		codeLabel:   status.Code(err).String(),
+	}
+	m.requests.With(labels).Inc()
+	m.duration.With(labels).Observe(duration.Seconds())
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+}
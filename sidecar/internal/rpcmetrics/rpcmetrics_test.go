@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpcmetrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+func TestMetrics(t *testing.T) {
+	m := New()
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(m))
+
+	interceptor := m.UnaryClientInterceptor()
+	call := func(method string, err error) {
+		invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+			return err
+		}
+		gotErr := interceptor(context.Background(), method, nil, nil, nil, invoker)
+		assert.Equal(t, err, gotErr)
+	}
+
+	// driver name is unknown when getting driver info
+	call(cosiproto.Identity_DriverGetInfo_FullMethodName, nil)
+
+	m.SetDriverName("s3.cosi.test")
+	call(cosiproto.Provisioner_DriverCreateBucket_FullMethodName, nil)
+	call(cosiproto.Provisioner_DriverCreateBucket_FullMethodName, nil)
+	call(cosiproto.Provisioner_DriverCreateBucket_FullMethodName, status.Error(codes.Unavailable, "down"))
+	call(cosiproto.Provisioner_DriverDeleteBucket_FullMethodName, status.Error(codes.DeadlineExceeded, "slow"))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	byName := map[string]*dto.MetricFamily{}
+	for _, f := range families {
+		byName[f.GetName()] = f
+	}
+
+	wantCounts := map[[3]string]uint64{
+		{"", "DriverGetInfo", "OK"}:                                1,
+		{"s3.cosi.test", "DriverCreateBucket", "OK"}:               2,
+		{"s3.cosi.test", "DriverCreateBucket", "Unavailable"}:      1,
+		{"s3.cosi.test", "DriverDeleteBucket", "DeadlineExceeded"}: 1,
+	}
+
+	requests := byName[RequestsTotalName]
+	require.NotNil(t, requests)
+	gotRequests := map[[3]string]uint64{}
+	for _, metric := range requests.GetMetric() {
+		gotRequests[labelValues(metric)] = uint64(metric.GetCounter().GetValue())
+	}
+	assert.Equal(t, wantCounts, gotRequests)
+
+	duration := byName[DurationSecondsName]
+	require.NotNil(t, duration)
+	assert.Equal(t, dto.MetricType_HISTOGRAM, duration.GetType())
+	gotDurations := map[[3]string]uint64{}
+	for _, metric := range duration.GetMetric() {
+		gotDurations[labelValues(metric)] = metric.GetHistogram().GetSampleCount()
+	}
+	assert.Equal(t, wantCounts, gotDurations)
+}
+
+// get driver, method, code label values from a metric
+func labelValues(metric *dto.Metric) [3]string {
+	out := [3]string{}
+	for _, l := range metric.GetLabel() {
+		switch l.GetName() {
+		case driverLabel:
+			out[0] = l.GetValue()
+		case methodLabel:
+			out[1] = l.GetValue()
+		case codeLabel:
+			out[2] = l.GetValue()
+		}
+	}
+	return out
+}
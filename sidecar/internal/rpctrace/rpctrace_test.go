@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rpctrace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	cositest "sigs.k8s.io/container-object-storage-interface/internal/test"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+// spanRecorder is a span exporter that keeps ended spans in memory.
+type spanRecorder struct {
+	mu    sync.Mutex
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (r *spanRecorder) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, spans...)
+	return nil
+}
+
+func (r *spanRecorder) Shutdown(context.Context) error { return nil }
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	recorder := &spanRecorder{}
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+
+	var driverMetadata metadata.MD
+	fakeServer := cositest.FakeProvisionerServer{
+		CreateBucketFunc: func(
+			ctx context.Context, dcbr *cosiproto.DriverCreateBucketRequest,
+		) (*cosiproto.DriverCreateBucketResponse, error) {
+			driverMetadata, _ = metadata.FromIncomingContext(ctx)
+			if dcbr.Name == "fail" {
+				return nil, status.Error(codes.Unavailable, "down")
+			}
+			return &cosiproto.DriverCreateBucketResponse{BucketId: dcbr.Name}, nil
+		},
+	}
+	cleanup, serve, tmpSock, err := cositest.RpcServer(nil, &fakeServer)
+	defer cleanup()
+	require.NoError(t, err)
+	go serve()
+
+	conn, err := cositest.RpcClientConn(tmpSock)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	interceptor := UnaryClientInterceptor()
+	invoker := func(ctx context.Context, method string, req, reply any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		return conn.Invoke(ctx, method, req, reply, opts...)
+	}
+	createBucket := func(ctx context.Context, name string) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "other", "value")
+		return interceptor(ctx, cosiproto.Provisioner_DriverCreateBucket_FullMethodName,
+			&cosiproto.DriverCreateBucketRequest{Name: name}, &cosiproto.DriverCreateBucketResponse{},
+			conn, invoker)
+	}
+
+	ctx, reconcileSpan := cositracing.Tracer().Start(context.Background(), "Reconcile Bucket")
+	require.NoError(t, createBucket(ctx, "ok"))
+	reconcileSpan.End()
+
+	require.Len(t, recorder.spans, 2)
+	rpcSpan := recorder.spans[0]
+	assert.Equal(t, "sigs.k8s.io.cosi.v1alpha2.Provisioner/DriverCreateBucket", rpcSpan.Name())
+	assert.Equal(t, trace.SpanKindClient, rpcSpan.SpanKind())
+	assert.Equal(t, reconcileSpan.SpanContext().SpanID(), rpcSpan.Parent().SpanID())
+	assert.Equal(t, otelcodes.Unset, rpcSpan.Status().Code)
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "sigs.k8s.io.cosi.v1alpha2.Provisioner"),
+		attribute.String("rpc.method", "DriverCreateBucket"),
+		attribute.Int("rpc.grpc.status_code", 0),
+	}, rpcSpan.Attributes())
+
+	// the driver receives the RPC span's trace context, along with any other metadata
+	assert.Equal(t, []string{"value"}, driverMetadata.Get("other"))
+	require.Len(t, driverMetadata.Get("traceparent"), 1)
+	driverSC := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(),
+		propagation.HeaderCarrier{"Traceparent": driverMetadata.Get("traceparent")}))
+	assert.Equal(t, rpcSpan.SpanContext().TraceID(), driverSC.TraceID())
+	assert.Equal(t, rpcSpan.SpanContext().SpanID(), driverSC.SpanID())
+
+	// errors are recorded on the RPC span
+	err = createBucket(context.Background(), "fail")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	require.Len(t, recorder.spans, 3)
+	failSpan := recorder.spans[2]
+	assert.False(t, failSpan.Parent().IsValid())
+	assert.Equal(t, otelcodes.Error, failSpan.Status().Code)
+	assert.Equal(t, "down", failSpan.Status().Description)
+	assert.Contains(t, failSpan.Attributes(), attribute.Int("rpc.grpc.status_code", int(codes.Unavailable)))
+}
@@ -27,7 +27,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,9 +39,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
 	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosievents "sigs.k8s.io/container-object-storage-interface/internal/events"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
 	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
 	"sigs.k8s.io/container-object-storage-interface/internal/protocol"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
 	"sigs.k8s.io/container-object-storage-interface/sidecar/internal/translator"
 )
@@ -48,16 +55,34 @@ type BucketReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	DriverInfo DriverInfo
+
+	// Recorder records Events about reconciled resources. If nil, no Events are recorded.
+	Recorder events.EventRecorder
+
+	// Connection tracks the driver's connection state and current info. If set, it takes
+	// precedence over DriverInfo, and reconciles are paused while the driver is disconnected.
+	Connection *DriverConnection
 }
 
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=buckets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=events.k8s.io,resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	logger := ctrl.LoggerFrom(ctx, "driverName", r.DriverInfo.Name)
+	driverInfo, connected := currentDriverInfo(r.DriverInfo, r.Connection)
+	logger := ctrl.LoggerFrom(ctx, "driverName", driverInfo.Name)
+	if !connected {
+		// Calls to a disconnected driver would only fail with Unavailable errors.
+		logger.V(1).Info("driver is disconnected; pausing reconcile")
+		return ctrl.Result{RequeueAfter: driverDisconnectedRequeueDelay}, nil
+	}
+	// Use the current driver info for the whole reconcile, even if the driver reconnects meanwhile.
+	snapshot := *r
+	snapshot.DriverInfo = driverInfo
+	r = &snapshot
 
 	bucket := &cosiapi.Bucket{}
 	if err := r.Get(ctx, req.NamespacedName, bucket); err != nil {
@@ -70,13 +95,21 @@ func (r *BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, err
 	}
 
+	ctx, span := cositracing.StartReconcile(ctx, cosimetrics.KindBucket, bucket, bucket.Status.ReadyToUse)
+	defer span.End()
+
 	err := r.reconcile(ctx, logger, bucket)
+	cositracing.RecordError(span, err)
 	if err != nil {
 		// Record any error as a timestamped error in the status.
 		if bucket.Status.ReadyToUse == nil {
 			bucket.Status.ReadyToUse = ptr.To(false)
 		}
 		bucket.Status.Error = cosiapi.NewTimestampedError(time.Now(), err.Error())
+		cosiconditions.SetReconcileError(bucket, &bucket.Status.Conditions, cosiapi.ConditionProvisioned,
+			bucket.Status.ReadyToUse, err)
+		cosievents.ReconcileError(r.Recorder, bucket, err)
+		r.recordDriverErrorForBucketClaim(bucket, err)
 		if updErr := r.Status().Update(ctx, bucket); updErr != nil {
 			logger.Error(err, "failed to update Bucket status after reconcile error", "updateError", updErr)
 			// If status update fails, we must retry the error regardless of the reconcile return.
@@ -85,17 +118,21 @@ func (r *BucketReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		}
 
 		if errors.Is(err, cosierr.NonRetryableError(nil)) {
+			cosimetrics.CountNonRetryableError(cosimetrics.KindBucket, r.DriverInfo.Name)
 			return reconcile.Result{}, reconcile.TerminalError(err)
 		}
 		return reconcile.Result{}, err
 	}
 
 	// On success, clear any errors in the status.
-	if bucket.Status.Error != nil && !bucket.DeletionTimestamp.IsZero() {
+	// Without the protection finalizer, a deleting Bucket may already be gone.
+	if bucket.Status.Error != nil && !bucket.DeletionTimestamp.IsZero() &&
+		ctrlutil.ContainsFinalizer(bucket, cosiapi.ProtectionFinalizer) {
 		if bucket.Status.ReadyToUse == nil {
 			bucket.Status.ReadyToUse = ptr.To(false)
 		}
 		bucket.Status.Error = nil
+		apimeta.RemoveStatusCondition(&bucket.Status.Conditions, cosiapi.ConditionDeletionBlocked)
 		if err := r.Status().Update(ctx, bucket); err != nil {
 			logger.Error(err, "failed to update BucketClaim status after reconcile success")
 			// Retry the reconcile so status can be updated eventually.
@@ -137,16 +174,7 @@ func (r *BucketReconciler) reconcile(ctx context.Context, logger logr.Logger, bu
 
 	if !bucket.GetDeletionTimestamp().IsZero() {
 		logger.V(1).Info("beginning Bucket deletion cleanup")
-
-		// TODO: deletion logic
-
-		ctrlutil.RemoveFinalizer(bucket, cosiapi.ProtectionFinalizer)
-		if err := r.Update(ctx, bucket); err != nil {
-			logger.Error(err, "failed to remove finalizer")
-			return fmt.Errorf("failed to remove finalizer: %w", err)
-		}
-
-		return cosierr.NonRetryableError(fmt.Errorf("deletion is not yet implemented")) // TODO
+		return r.reconcileDelete(ctx, logger, bucket)
 	}
 
 	requiredProtos, err := objectProtocolListFromApiList(bucket.Spec.Protocols)
@@ -167,6 +195,11 @@ func (r *BucketReconciler) reconcile(ctx context.Context, logger logr.Logger, bu
 		logger = logger.WithValues("provisioningStrategy", "dynamic")
 	}
 
+	if err := validateDriverSupportsBucket(r.DriverInfo, bucket); err != nil {
+		logger.Error(err, "Bucket requires unsupported driver capabilities")
+		return cosierr.NonRetryableError(err)
+	}
+
 	logger.V(1).Info("reconciling Bucket")
 
 	didAdd := ctrlutil.AddFinalizer(bucket, cosiapi.ProtectionFinalizer)
@@ -209,17 +242,114 @@ func (r *BucketReconciler) reconcile(ctx context.Context, logger logr.Logger, bu
 		return cosierr.NonRetryableError(fmt.Errorf("bucket required protocols missing: %w", err))
 	}
 
+	wasReady := ptr.Deref(bucket.Status.ReadyToUse, false)
 	bucket.Status = cosiapi.BucketStatus{
 		ReadyToUse: ptr.To(true),
 		BucketID:   provisionedBucket.bucketId,
 		Protocols:  provisionedBucket.supportedProtos,
 		BucketInfo: provisionedBucket.allProtoBucketInfo,
 		Error:      nil,
+		Conditions: bucket.Status.Conditions,
 	}
+	cosiconditions.SetSucceeded(bucket, &bucket.Status.Conditions, cosiapi.ConditionProvisioned)
+	cosiconditions.SetReady(bucket, &bucket.Status.Conditions, bucket.Status.ReadyToUse)
 	if err := r.Status().Update(ctx, bucket); err != nil {
 		logger.Error(err, "failed to update Bucket status after successful bucket creation")
 		return fmt.Errorf("failed to update Bucket status after successful bucket creation: %w", err)
 	}
+	if !wasReady {
+		cosimetrics.ObserveReady(cosimetrics.KindBucket, r.DriverInfo.Name, bucket)
+		cosievents.Normal(r.Recorder, bucket, nil, cosievents.ReasonProvisioned, cosievents.ActionProvision,
+			"driver provisioned bucket %q", provisionedBucket.bucketId)
+	}
+
+	return nil
+}
+
+func (r *BucketReconciler) reconcileDelete(ctx context.Context, logger logr.Logger, bucket *cosiapi.Bucket) error {
+	if !ctrlutil.ContainsFinalizer(bucket, cosiapi.ProtectionFinalizer) {
+		// Without the finalizer, the Bucket was never reconciled by the Sidecar, and it can be
+		// removed by Kubernetes at any time.
+		logger.V(1).Info("no Bucket deletion cleanup needed")
+		return nil
+	}
+
+	logger = logger.WithValues("deletionPolicy", bucket.Spec.DeletionPolicy)
+
+	switch {
+	case bucket.Spec.DeletionPolicy != cosiapi.BucketDeletionPolicyDelete:
+		logger.Info("retaining backend bucket")
+	case bucket.Status.BucketID == "":
+		logger.Info("not calling driver to delete bucket with no recorded bucketID")
+	case !r.DriverInfo.SupportsBucketDeletion():
+		// Keep the finalizer so that the backend bucket isn't orphaned without any indication.
+		// Administrators can change the deletionPolicy to Retain to allow deletion to proceed.
+		err := fmt.Errorf("driver %q does not support bucket deletion: deletionPolicy %q cannot be fulfilled",
+			r.DriverInfo.Name, bucket.Spec.DeletionPolicy)
+		logger.Error(err, "unable to delete bucket")
+		return cosierr.NonRetryableError(err)
+	default:
+		logger.Info("calling driver to delete bucket", "bucketID", bucket.Status.BucketID)
+		if err := r.driverDeleteBucket(ctx, logger, bucket); err != nil {
+			return err
+		}
+	}
+
+	ctrlutil.RemoveFinalizer(bucket, cosiapi.ProtectionFinalizer)
+	if err := r.Update(ctx, bucket); err != nil {
+		logger.Error(err, "failed to remove finalizer")
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	return nil
+}
+
+// Record a driver error as an Event on the Bucket's BucketClaim. Users usually can't read
+// cluster-scoped Buckets, so this lets them see why the driver can't fulfill their BucketClaim.
+func (r *BucketReconciler) recordDriverErrorForBucketClaim(bucket *cosiapi.Bucket, err error) {
+	if _, isRpcErr := status.FromError(err); !isRpcErr {
+		return
+	}
+	claimRef := bucket.Spec.BucketClaimRef
+	if claimRef.Namespace == "" || claimRef.Name == "" {
+		return
+	}
+
+	// The Event only needs a reference to the BucketClaim, which the Sidecar doesn't otherwise read.
+	claim := &cosiapi.BucketClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: claimRef.Namespace,
+			Name:      claimRef.Name,
+			UID:       claimRef.UID,
+		},
+	}
+	action := cosievents.ActionProvision
+	if !bucket.GetDeletionTimestamp().IsZero() {
+		action = cosievents.ActionDelete
+	}
+	cosievents.Warning(r.Recorder, claim, bucket, cosiconditions.Reason(err), action,
+		"driver error for Bucket %q: %s", bucket.Name, err.Error())
+}
+
+// Call the driver to delete the backend bucket.
+func (r *BucketReconciler) driverDeleteBucket(ctx context.Context, logger logr.Logger, bucket *cosiapi.Bucket) error {
+	_, err := r.DriverInfo.ProvisionerClient.DriverDeleteBucket(ctx,
+		&cosiproto.DriverDeleteBucketRequest{
+			BucketId:   bucket.Status.BucketID,
+			Parameters: bucket.Spec.Parameters,
+		},
+	)
+	if err != nil {
+		logger.Error(err, "DriverDeleteBucket error")
+		if rpcErrorIsRetryable(status.Code(err)) {
+			return err
+		}
+		// Do not remove the finalizer after a non-retryable error. Removing it would risk leaving
+		// the backend bucket orphaned without any clear indication to administrators that they
+		// need to do manual cleanup. If this is a sidecar or driver error, an update could resolve
+		// the issue to allow a future deletion to succeed.
+		return cosierr.NonRetryableError(err)
+	}
 
 	return nil
 }
@@ -407,6 +537,20 @@ func validateDriverSupportsProtocols(driver DriverInfo, required []*cosiproto.Ob
 	return nil
 }
 
+// validate that the driver supports the capabilities the Bucket requires
+func validateDriverSupportsBucket(driver DriverInfo, bucket *cosiapi.Bucket) error {
+	if bucket.Spec.ExistingBucketID != "" && !driver.SupportsStaticProvisioning() {
+		return fmt.Errorf("driver %q does not support statically-provisioned buckets", driver.Name)
+	}
+
+	if bucket.Spec.DeletionPolicy == cosiapi.BucketDeletionPolicyDelete && !driver.SupportsBucketDeletion() {
+		return fmt.Errorf("driver %q does not support bucket deletion required by deletionPolicy %q",
+			driver.Name, bucket.Spec.DeletionPolicy)
+	}
+
+	return nil
+}
+
 // validate the required protocols (if given) are in the supported list (from bucket provisioning results)
 func validateBucketSupportsProtocols(supported, required []cosiapi.ObjectProtocol) error {
 	unsupported := []string{}
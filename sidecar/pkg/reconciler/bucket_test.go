@@ -18,6 +18,7 @@ package reconciler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -27,8 +28,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -95,6 +100,7 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 		}
 		bootstrapped := cositest.MustBootstrap(t, b)
 		ctx := bootstrapped.ContextWithLogger
+		recorder := events.NewFakeRecorder(10)
 
 		r := BucketReconciler{
 			Client: bootstrapped.Client,
@@ -104,11 +110,14 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 				SupportedProtocols: []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3},
 				ProvisionerClient:  rpcClient,
 			},
+			Recorder: recorder,
 		}
 
 		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: bucketNsName})
 		assert.NoError(t, err)
 		assert.Empty(t, res)
+		assert.Equal(t, []string{`Normal Provisioned driver provisioned bucket "cosi-bc-qwerty"`},
+			recordedEvents(recorder))
 
 		// ensure the expected RPC call was made
 		require.Len(t, seenReq, 1)
@@ -160,6 +169,7 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 		assert.Equal(t, bucket.Finalizers, secondBucket.Finalizers)
 		assert.Equal(t, bucket.Spec, secondBucket.Spec)
 		assert.Equal(t, bucket.Status, secondBucket.Status)
+		assert.Empty(t, recordedEvents(recorder)) // already provisioned
 
 		t.Log("run Reconcile() that fails a third time to ensure status error")
 
@@ -189,6 +199,17 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 		assert.NotNil(t, serr.Time)
 		assert.NotNil(t, serr.Message)
 		assert.Contains(t, *serr.Message, "fake rpc error")
+		provisioned := apimeta.FindStatusCondition(thirdBucket.Status.Conditions, cosiapi.ConditionProvisioned)
+		require.NotNil(t, provisioned)
+		assert.Equal(t, meta.ConditionFalse, provisioned.Status)
+		assert.Equal(t, "DriverUnknown", provisioned.Reason) // plain errors from a gRPC server have code Unknown
+		assert.Contains(t, provisioned.Message, "fake rpc error")
+		assert.True(t, apimeta.IsStatusConditionTrue(thirdBucket.Status.Conditions, cosiapi.ConditionReady))
+		// driver errors are also reported to the BucketClaim's namespace
+		assert.Equal(t, []string{
+			"Warning DriverUnknown " + *serr.Message,
+			`Warning DriverUnknown driver error for Bucket "bc-qwerty": ` + *serr.Message,
+		}, recordedEvents(recorder))
 
 		t.Log("run Reconcile() that passes a fourth time to ensure status error cleared")
 
@@ -209,7 +230,11 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, secondBucket.Finalizers, fourthBucket.Finalizers)
 		assert.Equal(t, secondBucket.Spec, fourthBucket.Spec)
-		assert.Equal(t, secondBucket.Status, fourthBucket.Status) // reverts back to 2nd iteration
+		// reverts back to 2nd iteration, apart from condition transition times
+		assert.True(t, apimeta.IsStatusConditionTrue(fourthBucket.Status.Conditions, cosiapi.ConditionProvisioned))
+		secondBucket.Status.Conditions = nil
+		fourthBucket.Status.Conditions = nil
+		assert.Equal(t, secondBucket.Status, fourthBucket.Status)
 	})
 
 	t.Run("dynamic provisioning, bucket missing", func(t *testing.T) {
@@ -847,8 +872,354 @@ func TestBucketReconciler_Reconcile(t *testing.T) {
 		assert.Contains(t, *serr.Message, "protocols are not supported")
 		assert.Contains(t, *serr.Message, "S3")
 	})
+}
+
+func TestBucketReconciler_deletion(t *testing.T) {
+	baseBucket := cosiapi.Bucket{
+		ObjectMeta: meta.ObjectMeta{
+			Name:       "bc-qwerty",
+			Finalizers: []string{cosiapi.ProtectionFinalizer},
+		},
+		Spec: cosiapi.BucketSpec{
+			DriverName:     "cosi.s3.corp.net",
+			DeletionPolicy: cosiapi.BucketDeletionPolicyDelete,
+			Protocols:      []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolS3},
+			Parameters: map[string]string{
+				"maxSize": "10Gi",
+			},
+			BucketClaimRef: cosiapi.BucketClaimReference{
+				Name:      "my-bucket",
+				Namespace: "my-ns",
+				UID:       "qwerty",
+			},
+		},
+		Status: cosiapi.BucketStatus{
+			ReadyToUse: ptr.To(true),
+			BucketID:   "cosi-bc-qwerty",
+			Protocols:  []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolS3},
+		},
+	}
+
+	bucketNsName := types.NamespacedName{Name: "bc-qwerty"}
+
+	tests := []struct {
+		name string
+		// modifies the base bucket before deletion
+		modify         func(*cosiapi.Bucket)
+		deleteErr      error
+		wantDriverCall bool
+		wantErr        bool
+		wantTerminal   bool
+		wantGone       bool
+	}{
+		{"dynamic, Delete policy",
+			func(b *cosiapi.Bucket) {},
+			nil, true, false, false, true,
+		},
+		{"static, Delete policy",
+			func(b *cosiapi.Bucket) {
+				b.Spec.BucketClaimRef.UID = ""
+				b.Spec.ExistingBucketID = "cosi-bc-qwerty"
+			},
+			nil, true, false, false, true,
+		},
+		{"dynamic, Retain policy",
+			func(b *cosiapi.Bucket) { b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyRetain },
+			nil, false, false, false, true,
+		},
+		{"static, Retain policy",
+			func(b *cosiapi.Bucket) {
+				b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyRetain
+				b.Spec.BucketClaimRef.UID = ""
+				b.Spec.ExistingBucketID = "cosi-bc-qwerty"
+			},
+			nil, false, false, false, true,
+		},
+		{"Delete policy, bucket never provisioned",
+			func(b *cosiapi.Bucket) { b.Status = cosiapi.BucketStatus{} },
+			nil, false, false, false, true,
+		},
+		{"Delete policy, driver returns NotFound",
+			func(b *cosiapi.Bucket) {},
+			status.Error(codes.NotFound, "bucket not found"), true, true, false, false,
+		},
+		{"Delete policy, retryable driver error",
+			func(b *cosiapi.Bucket) {},
+			status.Error(codes.Unavailable, "backend unavailable"), true, true, false, false,
+		},
+		{"Delete policy, non-retryable driver error",
+			func(b *cosiapi.Bucket) {},
+			status.Error(codes.InvalidArgument, "bucket has objects"), true, true, true, false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seenReq := []*cosiproto.DriverDeleteBucketRequest{}
+			fakeServer := cositest.FakeProvisionerServer{
+				DeleteBucketFunc: func(ctx context.Context, ddbr *cosiproto.DriverDeleteBucketRequest) (*cosiproto.DriverDeleteBucketResponse, error) {
+					seenReq = append(seenReq, ddbr)
+					if tt.deleteErr != nil {
+						return nil, tt.deleteErr
+					}
+					return &cosiproto.DriverDeleteBucketResponse{}, nil
+				},
+			}
 
-	// TODO: deletion (dynamic and static, Retain/Delete)
+			cleanup, serve, tmpSock, err := cositest.RpcServer(nil, &fakeServer)
+			defer cleanup()
+			require.NoError(t, err)
+			go serve()
+
+			conn, err := cositest.RpcClientConn(tmpSock)
+			require.NoError(t, err)
+			rpcClient := cosiproto.NewProvisionerClient(conn)
+
+			b := baseBucket.DeepCopy()
+			tt.modify(b)
+			bootstrapped := cositest.MustBootstrap(t, b)
+			ctx := bootstrapped.ContextWithLogger
+
+			r := BucketReconciler{
+				Client: bootstrapped.Client,
+				Scheme: bootstrapped.Client.Scheme(),
+				DriverInfo: DriverInfo{
+					Name:               "cosi.s3.corp.net",
+					SupportedProtocols: []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3},
+					ProvisionerClient:  rpcClient,
+				},
+			}
+
+			require.NoError(t, r.Delete(ctx, b))
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: bucketNsName})
+			assert.Empty(t, res)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTerminal, errors.Is(err, reconcile.TerminalError(nil)))
+
+			if tt.wantDriverCall {
+				require.Len(t, seenReq, 1)
+				assert.Equal(t, "cosi-bc-qwerty", seenReq[0].BucketId)
+				assert.Equal(t, map[string]string{"maxSize": "10Gi"}, seenReq[0].Parameters)
+			} else {
+				assert.Empty(t, seenReq)
+			}
+
+			bucket := &cosiapi.Bucket{}
+			err = r.Get(ctx, bucketNsName, bucket)
+			if tt.wantGone {
+				assert.True(t, kerrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, bucket.GetFinalizers(), cosiapi.ProtectionFinalizer)
+			require.NotNil(t, bucket.Status.Error)
+			assert.Contains(t, *bucket.Status.Error.Message, tt.deleteErr.Error())
+		})
+	}
+}
+
+func TestBucketReconciler_unsupportedCapabilities(t *testing.T) {
+	baseBucket := cosiapi.Bucket{
+		ObjectMeta: meta.ObjectMeta{
+			Name:       "bc-qwerty",
+			Finalizers: []string{cosiapi.ProtectionFinalizer},
+		},
+		Spec: cosiapi.BucketSpec{
+			DriverName:     "cosi.s3.corp.net",
+			DeletionPolicy: cosiapi.BucketDeletionPolicyRetain,
+			Protocols:      []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolS3},
+			BucketClaimRef: cosiapi.BucketClaimReference{
+				Name:      "my-bucket",
+				Namespace: "my-ns",
+				UID:       "qwerty",
+			},
+		},
+	}
+
+	bucketNsName := types.NamespacedName{Name: "bc-qwerty"}
+
+	tests := []struct {
+		name         string
+		capabilities *cosiproto.DriverCapabilities
+		// modifies the base bucket
+		modify  func(*cosiapi.Bucket)
+		delete  bool
+		wantErr string
+	}{
+		{"static provisioning unsupported",
+			&cosiproto.DriverCapabilities{BucketDeletion: true},
+			func(b *cosiapi.Bucket) { b.Spec.ExistingBucketID = "static-bucket" },
+			false, "does not support statically-provisioned buckets",
+		},
+		{"Delete policy, deletion unsupported",
+			&cosiproto.DriverCapabilities{StaticProvisioning: true},
+			func(b *cosiapi.Bucket) { b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyDelete },
+			false, "does not support bucket deletion",
+		},
+		{"deleting with Delete policy, deletion unsupported",
+			&cosiproto.DriverCapabilities{StaticProvisioning: true},
+			func(b *cosiapi.Bucket) {
+				b.Spec.DeletionPolicy = cosiapi.BucketDeletionPolicyDelete
+				b.Status.ReadyToUse = ptr.To(true)
+				b.Status.BucketID = "cosi-bc-qwerty"
+			},
+			true, "does not support bucket deletion",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rpcCalls := 0
+			fakeServer := cositest.FakeProvisionerServer{
+				CreateBucketFunc: func(ctx context.Context, dcbr *cosiproto.DriverCreateBucketRequest) (*cosiproto.DriverCreateBucketResponse, error) {
+					rpcCalls++
+					return nil, fmt.Errorf("unexpected call")
+				},
+				GetExistingBucketFunc: func(ctx context.Context, dgebr *cosiproto.DriverGetExistingBucketRequest) (*cosiproto.DriverGetExistingBucketResponse, error) {
+					rpcCalls++
+					return nil, fmt.Errorf("unexpected call")
+				},
+				DeleteBucketFunc: func(ctx context.Context, ddbr *cosiproto.DriverDeleteBucketRequest) (*cosiproto.DriverDeleteBucketResponse, error) {
+					rpcCalls++
+					return nil, fmt.Errorf("unexpected call")
+				},
+			}
+
+			cleanup, serve, tmpSock, err := cositest.RpcServer(nil, &fakeServer)
+			defer cleanup()
+			require.NoError(t, err)
+			go serve()
+
+			conn, err := cositest.RpcClientConn(tmpSock)
+			require.NoError(t, err)
+			rpcClient := cosiproto.NewProvisionerClient(conn)
+
+			b := baseBucket.DeepCopy()
+			tt.modify(b)
+			bootstrapped := cositest.MustBootstrap(t, b)
+			ctx := bootstrapped.ContextWithLogger
+
+			r := BucketReconciler{
+				Client: bootstrapped.Client,
+				Scheme: bootstrapped.Client.Scheme(),
+				DriverInfo: DriverInfo{
+					Name:               "cosi.s3.corp.net",
+					SupportedProtocols: []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3},
+					Capabilities:       tt.capabilities,
+					ProvisionerClient:  rpcClient,
+				},
+			}
+
+			if tt.delete {
+				require.NoError(t, r.Delete(ctx, b))
+			}
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: bucketNsName})
+			assert.Empty(t, res)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+			assert.Zero(t, rpcCalls)
+
+			bucket := &cosiapi.Bucket{}
+			require.NoError(t, r.Get(ctx, bucketNsName, bucket))
+			assert.Contains(t, bucket.GetFinalizers(), cosiapi.ProtectionFinalizer)
+			require.NotNil(t, bucket.Status.Error)
+			assert.Contains(t, *bucket.Status.Error.Message, tt.wantErr)
+		})
+	}
+}
+
+func TestBucketReconciler_driverConnection(t *testing.T) {
+	bucket := &cosiapi.Bucket{
+		ObjectMeta: meta.ObjectMeta{
+			Name: "bc-qwerty",
+		},
+		Spec: cosiapi.BucketSpec{
+			DriverName:     "cosi.s3.corp.net",
+			DeletionPolicy: cosiapi.BucketDeletionPolicyRetain,
+			Protocols:      []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolAzure},
+			BucketClaimRef: cosiapi.BucketClaimReference{
+				Name:      "my-bucket",
+				Namespace: "my-ns",
+				UID:       "qwerty",
+			},
+		},
+	}
+
+	bucketNsName := types.NamespacedName{Name: "bc-qwerty"}
+
+	rpcCalls := 0
+	fakeServer := cositest.FakeProvisionerServer{
+		CreateBucketFunc: func(ctx context.Context, dcbr *cosiproto.DriverCreateBucketRequest) (*cosiproto.DriverCreateBucketResponse, error) {
+			rpcCalls++
+			return &cosiproto.DriverCreateBucketResponse{
+				BucketId: "cosi-" + dcbr.Name,
+				Protocols: &cosiproto.ObjectProtocolAndBucketInfo{
+					Azure: &cosiproto.AzureBucketInfo{StorageAccount: "corp-cosi"},
+				},
+			}, nil
+		},
+	}
+
+	cleanup, serve, tmpSock, err := cositest.RpcServer(nil, &fakeServer)
+	defer cleanup()
+	require.NoError(t, err)
+	go serve()
+
+	conn, err := cositest.RpcClientConn(tmpSock)
+	require.NoError(t, err)
+	rpcClient := cosiproto.NewProvisionerClient(conn)
+
+	bootstrapped := cositest.MustBootstrap(t, bucket.DeepCopy())
+	ctx := bootstrapped.ContextWithLogger
+
+	initialInfo := DriverInfo{
+		Name:               "cosi.s3.corp.net",
+		SupportedProtocols: []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3},
+		ProvisionerClient:  rpcClient,
+	}
+	driverConnection := NewDriverConnection(initialInfo)
+	r := BucketReconciler{
+		Client:     bootstrapped.Client,
+		Scheme:     bootstrapped.Client.Scheme(),
+		DriverInfo: initialInfo,
+		Connection: driverConnection,
+	}
+
+	t.Run("driver disconnected", func(t *testing.T) {
+		driverConnection.SetDisconnected()
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: bucketNsName})
+		assert.NoError(t, err)
+		assert.Equal(t, reconcile.Result{RequeueAfter: driverDisconnectedRequeueDelay}, res)
+		assert.Zero(t, rpcCalls)
+
+		b := &cosiapi.Bucket{}
+		require.NoError(t, r.Get(ctx, bucketNsName, b))
+		assert.Equal(t, bucket.Status, b.Status) // status untouched
+	})
+
+	t.Run("driver reconnects with new protocol", func(t *testing.T) {
+		reconnectedInfo := initialInfo
+		reconnectedInfo.SupportedProtocols = []cosiproto.ObjectProtocol_Type{
+			cosiproto.ObjectProtocol_S3, cosiproto.ObjectProtocol_AZURE,
+		}
+		driverConnection.SetConnected(reconnectedInfo)
+
+		res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: bucketNsName})
+		assert.NoError(t, err)
+		assert.Empty(t, res)
+		assert.Equal(t, 1, rpcCalls)
+
+		b := &cosiapi.Bucket{}
+		require.NoError(t, r.Get(ctx, bucketNsName, b))
+		assert.True(t, *b.Status.ReadyToUse)
+		assert.Nil(t, b.Status.Error)
+		assert.Equal(t, []cosiapi.ObjectProtocol{cosiapi.ObjectProtocolAzure}, b.Status.Protocols)
+	})
 }
 
 func TestBucketReconciler_dynamicProvision(t *testing.T) {
@@ -1940,3 +2311,16 @@ func Test_validateBucketSupportsProtocols(t *testing.T) {
 		})
 	}
 }
+
+// Return all events recorded so far by the fake recorder.
+func recordedEvents(recorder *events.FakeRecorder) []string {
+	out := []string{}
+	for {
+		select {
+		case e := <-recorder.Events:
+			out = append(out, e)
+		default:
+			return out
+		}
+	}
+}
@@ -18,8 +18,12 @@ package reconciler
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -30,18 +34,25 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	ctrlpredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
 	"sigs.k8s.io/container-object-storage-interface/internal/bucketaccess"
+	cosiconditions "sigs.k8s.io/container-object-storage-interface/internal/conditions"
 	cosierr "sigs.k8s.io/container-object-storage-interface/internal/errors"
+	cosievents "sigs.k8s.io/container-object-storage-interface/internal/events"
+	cosimetrics "sigs.k8s.io/container-object-storage-interface/internal/metrics"
 	cosipredicate "sigs.k8s.io/container-object-storage-interface/internal/predicate"
 	"sigs.k8s.io/container-object-storage-interface/internal/protocol"
+	cositracing "sigs.k8s.io/container-object-storage-interface/internal/tracing"
 	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
 	"sigs.k8s.io/container-object-storage-interface/sidecar/internal/translator"
 )
@@ -51,16 +62,34 @@ type BucketAccessReconciler struct {
 	client.Client
 	Scheme     *runtime.Scheme
 	DriverInfo DriverInfo
+
+	// Recorder records Events about reconciled resources. If nil, no Events are recorded.
+	Recorder events.EventRecorder
+
+	// Connection tracks the driver's connection state and current info. If set, it takes
+	// precedence over DriverInfo, and reconciles are paused while the driver is disconnected.
+	Connection *DriverConnection
 }
 
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=objectstorage.k8s.io,resources=bucketaccesses/finalizers,verbs=update
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	logger := ctrl.LoggerFrom(ctx, "driverName", r.DriverInfo.Name)
+	driverInfo, connected := currentDriverInfo(r.DriverInfo, r.Connection)
+	logger := ctrl.LoggerFrom(ctx, "driverName", driverInfo.Name)
+	if !connected {
+		// Calls to a disconnected driver would only fail with Unavailable errors.
+		logger.V(1).Info("driver is disconnected; pausing reconcile")
+		return ctrl.Result{RequeueAfter: driverDisconnectedRequeueDelay}, nil
+	}
+	// Use the current driver info for the whole reconcile, even if the driver reconnects meanwhile.
+	snapshot := *r
+	snapshot.DriverInfo = driverInfo
+	r = &snapshot
 
 	access := &cosiapi.BucketAccess{}
 	if err := r.Get(ctx, req.NamespacedName, access); err != nil {
@@ -78,7 +107,11 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, nil
 	}
 
+	ctx, span := cositracing.StartReconcile(ctx, cosimetrics.KindBucketAccess, access, access.Status.ReadyToUse)
+	defer span.End()
+
 	err := r.reconcile(ctx, logger, access)
+	cositracing.RecordError(span, err)
 	if err != nil {
 		// Because the BucketAccess status is could be managed by either Sidecar or Controller,
 		// indicate that this error is coming from the Sidecar.
@@ -88,7 +121,15 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		if access.Status.ReadyToUse == nil {
 			access.Status.ReadyToUse = ptr.To(false)
 		}
+		if credentialsExpired(access, time.Now()) {
+			// Credentials could not be renewed before they expired. Workloads can't use them.
+			access.Status.ReadyToUse = ptr.To(false)
+		}
 		access.Status.Error = cosiapi.NewTimestampedError(time.Now(), err.Error())
+		// The Sidecar owns the AccessGranted condition. The Controller owns the Bound condition.
+		cosiconditions.SetReconcileError(access, &access.Status.Conditions, cosiapi.ConditionAccessGranted,
+			access.Status.ReadyToUse, err)
+		cosievents.ReconcileError(r.Recorder, access, err)
 		if updErr := r.Status().Update(ctx, access); updErr != nil {
 			logger.Error(err, "failed to update BucketAccess status after reconcile error", "updateError", updErr)
 			// If status update fails, we must retry the error regardless of the reconcile return.
@@ -96,7 +137,17 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			return reconcile.Result{}, err
 		}
 
-		if errors.Is(err, cosierr.NonRetryableError(nil)) {
+		nonRetryable := errors.Is(err, cosierr.NonRetryableError(nil))
+		if nonRetryable {
+			cosimetrics.CountNonRetryableError(cosimetrics.KindBucketAccess, r.DriverInfo.Name)
+		}
+		if delay := credentialExpiryRequeueDelay(access, !nonRetryable, time.Now()); delay > 0 {
+			// Credentials are still usable. Reconcile again no later than when they expire so that
+			// the access is marked not ready if they can't be renewed by then.
+			logger.V(1).Info("requeueing failed reconcile before access credentials expire", "requeueAfter", delay)
+			return reconcile.Result{RequeueAfter: delay}, nil
+		}
+		if nonRetryable {
 			return reconcile.Result{}, reconcile.TerminalError(err)
 		}
 		return reconcile.Result{}, err
@@ -106,29 +157,39 @@ func (r *BucketAccessReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	//   1. BucketAccess was granted successfully, and error was cleared in reconcile()
 	//   2. BucketAccess deletion cleanup was finished, and finalization is now passed to Controller
 
+	if next := nextCredentialUpdateTime(access, time.Now()); !next.IsZero() {
+		// Reconcile again when credentials are due for periodic rotation or renewal before expiry.
+		return reconcile.Result{RequeueAfter: time.Until(next)}, nil
+	}
+
 	return reconcile.Result{}, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *BucketAccessReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	// TODO: owns secrets, but don't reconcile secret changes made in this controller
-
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&cosiapi.BucketAccess{}).
-		WithEventFilter(
-			ctrlpredicate.And(
-				driverNameMatchesPredicate(r.DriverInfo.Name), // only opt in to reconciles with matching driver name
-				ctrlpredicate.Or(
-					// when managed by sidecar, we should reconcile ALL Create/Delete/Generic events
-					cosipredicate.AnyCreate(),
-					cosipredicate.AnyDelete(),
-					cosipredicate.AnyGeneric(),
-					// opt in to desired Update events
-					cosipredicate.BucketAccessHandoffOccurred(r.Scheme), // reconcile any handoff change
-					cosipredicate.ProtectionFinalizerRemoved(r.Scheme),  // re-add protection finalizer if removed
+		For(&cosiapi.BucketAccess{},
+			builder.WithPredicates(
+				ctrlpredicate.And(
+					driverNameMatchesPredicate(r.DriverInfo.Name), // only opt in to reconciles with matching driver name
+					ctrlpredicate.Or(
+						// when managed by sidecar, we should reconcile ALL Create/Delete/Generic events
+						cosipredicate.AnyCreate(),
+						cosipredicate.AnyDelete(),
+						cosipredicate.AnyGeneric(),
+						// opt in to desired Update events
+						cosipredicate.BucketAccessHandoffOccurred(r.Scheme), // reconcile any handoff change
+						cosipredicate.ProtectionFinalizerRemoved(r.Scheme),  // re-add protection finalizer if removed
+						// rotate credentials when requested
+						cosipredicate.AnnotationAdded(cosiapi.RotateCredentialsAnnotation),
+					),
 				),
 			),
 		).
+		Owns(&corev1.Secret{},
+			// repair access Secrets deleted or modified by others, but ignore this controller's writes
+			builder.WithPredicates(accessSecretModifiedExternallyPredicate()),
+		).
 		Complete(r)
 }
 
@@ -169,6 +230,10 @@ func (r *BucketAccessReconciler) reconcile(
 		}
 	}
 
+	if err := r.acceptCredentialRotationRequest(ctx, logger, access); err != nil {
+		return err
+	}
+
 	if err := getAndValidateAllAccessedBuckets(ctx, r.Client, access); err != nil {
 		logger.Error(err, "failed to validate accessed Buckets for BucketAccess")
 		return err
@@ -188,6 +253,135 @@ func (r *BucketAccessReconciler) reconcile(
 		return fmt.Errorf("failed to build internal representation of grant-access configuration: %w", err)
 	}
 
+	if err := validateDriverSupportsAccess(r.DriverInfo, grantCfg); err != nil {
+		logger.Error(err, "BucketAccess requires unsupported driver capabilities")
+		return cosierr.NonRetryableError(err)
+	}
+
+	grantDetails, err := r.driverGrantAccess(ctx, logger, access, grantCfg)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	rotated := false
+	var retiring *cosiapi.RetiringAccount
+	if credentialRotationDue(access, now) {
+		if gracePeriod := access.Status.CredentialRotationGracePeriod; gracePeriod != nil {
+			// Zero-downtime rotation: grant access to a new account, and keep the current account
+			// valid until workloads have had time to pick up the new account's credentials.
+			if len(access.Status.RetiringAccounts) >= maxRetiringAccounts {
+				err := fmt.Errorf("cannot rotate credentials while %d previous accounts are awaiting revocation",
+					len(access.Status.RetiringAccounts))
+				logger.Error(err, "failed to rotate access credentials")
+				return err
+			}
+
+			logger.Info("rotating access credentials to a new account", "previousAccountID", grantDetails.AccountId)
+			newGrantCfg := *grantCfg
+			newGrantCfg.AccountName = accessAccountName(access, access.Status.AccountGeneration+1)
+			newGrantDetails, err := r.driverGrantAccess(ctx, logger, access, &newGrantCfg)
+			if err != nil {
+				return err
+			}
+			if newGrantDetails.AccountId == grantDetails.AccountId {
+				err := fmt.Errorf("driver granted access to new account %q with existing account ID %q",
+					newGrantCfg.AccountName, grantDetails.AccountId)
+				logger.Error(err, "granted BucketAccess is invalid")
+				return cosierr.NonRetryableError(err)
+			}
+
+			retiring = &cosiapi.RetiringAccount{
+				AccountID:   grantDetails.AccountId,
+				RevokeAfter: metav1.NewTime(now.Add(gracePeriod.Duration)),
+			}
+			grantCfg, grantDetails = &newGrantCfg, newGrantDetails
+		} else {
+			logger.Info("rotating access credentials", "accountID", grantDetails.AccountId)
+			if err := r.driverRotateCredentials(ctx, logger, access, grantCfg, grantDetails); err != nil {
+				return err
+			}
+		}
+		rotated = true
+	}
+
+	if err := r.updateSecretsWithGrantedInfo(ctx, grantCfg, grantDetails); err != nil {
+		logger.Error(err, "failed to update BucketAccess Secret(s)")
+		return err
+	}
+
+	if retiring != nil {
+		// Status is changed only after Secrets hold the new account's credentials. Until then, a
+		// repeated rotation re-grants the same new account name idempotently.
+		access.Status.RetiringAccounts = append(access.Status.RetiringAccounts, *retiring)
+		access.Status.AccountGeneration++
+	}
+	wasReady := ptr.Deref(access.Status.ReadyToUse, false)
+	access.Status.AccountID = grantDetails.AccountId
+	access.Status.ReadyToUse = ptr.To(true)
+	access.Status.CredentialsExpiryTime = grantDetails.CredentialsExpiryTime
+	if rotated {
+		access.Status.LastCredentialRotationTime = ptr.To(metav1.NewTime(now))
+		access.Status.CredentialRotationRequested = false
+	}
+	access.Status.Error = nil
+	cosiconditions.SetSucceeded(access, &access.Status.Conditions, cosiapi.ConditionAccessGranted)
+	cosiconditions.SetReady(access, &access.Status.Conditions, access.Status.ReadyToUse)
+	if err := r.Status().Update(ctx, access); err != nil {
+		logger.Error(err, "failed to update BucketAccess status after successful access grant")
+		return fmt.Errorf("failed to update BucketAccess status after successful access grant: %w", err)
+	}
+	if !wasReady {
+		cosimetrics.ObserveReady(cosimetrics.KindBucketAccess, r.DriverInfo.Name, access)
+		cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonAccessGranted, cosievents.ActionGrant,
+			"driver granted access for account %q", access.Status.AccountID)
+	}
+	if rotated {
+		cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonCredentialsRotated, cosievents.ActionRotate,
+			"rotated credentials for account %q", access.Status.AccountID)
+	}
+
+	if err := r.revokeRetiredAccounts(ctx, logger, access, now); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Move a credential rotation request from the annotation into the status. The request is recorded
+// in the status before the annotation is removed so that it is neither lost nor handled twice if
+// either write fails. Rotation clears the request in the same status write that records it.
+func (r *BucketAccessReconciler) acceptCredentialRotationRequest(
+	ctx context.Context, logger logr.Logger, access *cosiapi.BucketAccess,
+) error {
+	if _, ok := access.Annotations[cosiapi.RotateCredentialsAnnotation]; !ok {
+		return nil
+	}
+
+	if !access.Status.CredentialRotationRequested {
+		logger.V(1).Info("accepting credential rotation request")
+		access.Status.CredentialRotationRequested = true
+		if err := r.Status().Update(ctx, access); err != nil {
+			logger.Error(err, "failed to record credential rotation request")
+			return fmt.Errorf("failed to record credential rotation request: %w", err)
+		}
+	}
+
+	delete(access.Annotations, cosiapi.RotateCredentialsAnnotation)
+	if err := r.Update(ctx, access); err != nil {
+		logger.Error(err, "failed to remove credential rotation request annotation")
+		return fmt.Errorf("failed to remove credential rotation request annotation: %w", err)
+	}
+	return nil
+}
+
+// Call the driver to grant access, and validate the driver's response.
+func (r *BucketAccessReconciler) driverGrantAccess(
+	ctx context.Context,
+	logger logr.Logger,
+	access *cosiapi.BucketAccess,
+	grantCfg *internalGrantAccessConfig,
+) (*grantedAccessApiDetails, error) {
 	resp, err := r.DriverInfo.ProvisionerClient.DriverGrantBucketAccess(ctx,
 		&cosiproto.DriverGrantBucketAccessRequest{
 			AccountName:        grantCfg.AccountName,
@@ -202,14 +396,14 @@ func (r *BucketAccessReconciler) reconcile(
 		if status.Code(err) == codes.OutOfRange {
 			err = fmt.Errorf("driver does not support multi-bucket access: %w", err)
 			logger.Error(err, "DriverGrantBucketAccess error")
-			return cosierr.NonRetryableError(err)
+			return nil, cosierr.NonRetryableError(err)
 		}
 
 		logger.Error(err, "DriverGrantBucketAccess error")
 		if rpcErrorIsRetryable(status.Code(err)) {
-			return err
+			return nil, err
 		}
-		return cosierr.NonRetryableError(err)
+		return nil, cosierr.NonRetryableError(err)
 	}
 
 	validation := translator.ValidationConfig{
@@ -219,25 +413,47 @@ func (r *BucketAccessReconciler) reconcile(
 	grantDetails, err := translateDriverGrantBucketAccessResponseToApi(resp, &validation)
 	if err != nil {
 		logger.Error(err, "failed processing BucketAccess RPC response")
-		return cosierr.NonRetryableError(err)
+		return nil, cosierr.NonRetryableError(err)
 	}
 
 	if err := validateGrantedAccess(grantCfg, grantDetails); err != nil {
 		logger.Error(err, "granted BucketAccess is invalid")
-		return cosierr.NonRetryableError(err)
+		return nil, cosierr.NonRetryableError(err)
 	}
 
-	if err := r.updateSecretsWithGrantedInfo(ctx, grantCfg, grantDetails); err != nil {
-		logger.Error(err, "failed to update BucketAccess Secret(s)")
-		return err
+	return grantDetails, nil
+}
+
+// Revoke previous accounts whose credential rotation grace period has ended.
+func (r *BucketAccessReconciler) revokeRetiredAccounts(
+	ctx context.Context, logger logr.Logger, access *cosiapi.BucketAccess, now time.Time,
+) error {
+	remaining := []cosiapi.RetiringAccount{}
+	for _, ra := range access.Status.RetiringAccounts {
+		if now.Before(ra.RevokeAfter.Time) {
+			remaining = append(remaining, ra)
+			continue
+		}
+
+		logger.Info("calling driver to revoke access for retired account", "accountID", ra.AccountID)
+		if err := driverRevokeAccess(ctx, logger, r.DriverInfo.ProvisionerClient, access, ra.AccountID); err != nil {
+			return err
+		}
+		cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonAccessRevoked, cosievents.ActionRevoke,
+			"revoked access for retired account %q", ra.AccountID)
 	}
 
-	access.Status.AccountID = grantDetails.AccountId
-	access.Status.ReadyToUse = ptr.To(true)
-	access.Status.Error = nil
+	if len(remaining) == len(access.Status.RetiringAccounts) {
+		return nil
+	}
+
+	if len(remaining) == 0 {
+		remaining = nil
+	}
+	access.Status.RetiringAccounts = remaining
 	if err := r.Status().Update(ctx, access); err != nil {
-		logger.Error(err, "failed to update BucketAccess status after successful access grant")
-		return fmt.Errorf("failed to update BucketAccess status after successful access grant: %w", err)
+		logger.Error(err, "failed to update BucketAccess status after revoking retired accounts")
+		return fmt.Errorf("failed to update BucketAccess status after revoking retired accounts: %w", err)
 	}
 
 	return nil
@@ -248,6 +464,7 @@ func (r *BucketAccessReconciler) reconcileDelete(
 ) error {
 	access.Status.ReadyToUse = ptr.To(false)
 	access.Status.Error = nil // previous error is no longer relevant
+	cosiconditions.SetReady(access, &access.Status.Conditions, access.Status.ReadyToUse)
 	if err := r.Status().Update(ctx, access); err != nil {
 		logger.Error(err, "failed to update BucketAccess status before deletion")
 		return fmt.Errorf("failed to update BucketAccess status before deletion: %w", err)
@@ -258,11 +475,27 @@ func (r *BucketAccessReconciler) reconcileDelete(
 		return err
 	}
 
+	for len(access.Status.RetiringAccounts) > 0 {
+		accountID := access.Status.RetiringAccounts[0].AccountID
+		logger.Info("calling driver to revoke access for retiring account", "accountID", accountID)
+		if err := driverRevokeAccess(ctx, logger, r.DriverInfo.ProvisionerClient, access, accountID); err != nil {
+			return err
+		}
+		cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonAccessRevoked, cosievents.ActionRevoke,
+			"revoked access for retiring account %q", accountID)
+		// If a later revocation fails, the status records which accounts remain to be revoked.
+		access.Status.RetiringAccounts = access.Status.RetiringAccounts[1:]
+	}
+	access.Status.RetiringAccounts = nil
+
 	if access.Status.AccountID != "" {
 		logger.Info("calling driver to revoke access", "accountID", access.Status.AccountID)
-		if err := driverRevokeAccess(ctx, logger, r.DriverInfo.ProvisionerClient, access); err != nil {
+		err := driverRevokeAccess(ctx, logger, r.DriverInfo.ProvisionerClient, access, access.Status.AccountID)
+		if err != nil {
 			return err
 		}
+		cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonAccessRevoked, cosievents.ActionRevoke,
+			"revoked access for account %q", access.Status.AccountID)
 	} else {
 		logger.Info("not calling driver to revoke access with no recorded accountID")
 	}
@@ -276,18 +509,21 @@ func (r *BucketAccessReconciler) reconcileDelete(
 		logger.Error(err, "failed to update BucketAccess after successful access revocation")
 		return fmt.Errorf("failed to update BucketAccess after successful access revocation: %w", err)
 	}
+	cosievents.Normal(r.Recorder, access, nil, cosievents.ReasonHandedOff, cosievents.ActionHandoff,
+		"finished BucketAccess cleanup and handed back to the COSI Controller")
 
 	return nil
 }
 
-// Call the driver to revoke access.
+// Call the driver to revoke access for the given account.
 func driverRevokeAccess(
 	ctx context.Context,
 	logger logr.Logger,
 	rpcClient cosiproto.ProvisionerClient,
 	access *cosiapi.BucketAccess,
+	accountID string,
 ) error {
-	revokeCfg, err := newInternalRevokeAccessConfig(access)
+	revokeCfg, err := newInternalRevokeAccessConfig(access, accountID)
 	if err != nil {
 		logger.Error(err, "failed to build internal representation of revoke-access configuration")
 		return fmt.Errorf("failed to build internal representation of revoke-access configuration: %w", err)
@@ -295,7 +531,7 @@ func driverRevokeAccess(
 
 	_, err = rpcClient.DriverRevokeBucketAccess(ctx,
 		&cosiproto.DriverRevokeBucketAccessRequest{
-			AccountId:          access.Status.AccountID,
+			AccountId:          revokeCfg.AccountID,
 			Protocol:           &cosiproto.ObjectProtocol{Type: revokeCfg.Protocol},
 			AuthenticationType: &cosiproto.AuthenticationType{Type: revokeCfg.AuthenticationType},
 			ServiceAccountName: revokeCfg.ServiceAccountName,
@@ -387,7 +623,7 @@ func newInternalGrantAccessConfig(
 		return nil, err
 	}
 
-	acctName := "ba-" + string(access.UID) // DO NOT CHANGE
+	acctName := accessAccountName(access, access.Status.AccountGeneration)
 
 	accessConfigsByBucketId, err := generateInternalAccessedBucketConfigs(access)
 	if err != nil {
@@ -405,6 +641,17 @@ func newInternalGrantAccessConfig(
 	return d, nil
 }
 
+// Get the driver account name for the given account generation of the BucketAccess.
+// Account names are derived from the BucketAccess UID so that repeated grants are idempotent.
+func accessAccountName(access *cosiapi.BucketAccess, generation int64) string {
+	name := "ba-" + string(access.UID) // DO NOT CHANGE
+	if generation > 0 {
+		// Each zero-downtime credential rotation grants access to a new account.
+		name = fmt.Sprintf("%s-%d", name, generation) // DO NOT CHANGE
+	}
+	return name
+}
+
 // Parse the referenced BucketClaims and accessed Buckets, then cross-reference and collate the info
 // into a form that makes internal operations easier.
 // The implementation uses maps to simplify lookups and avoid having to search slices for entries
@@ -471,14 +718,62 @@ func (d *internalGrantAccessConfig) RpcGrantBucketsList() []*cosiproto.DriverGra
 	return out
 }
 
-// Parse the access, and compile a new internal revoke-access config struct.
-func newInternalRevokeAccessConfig(access *cosiapi.BucketAccess) (*internalRevokeAccessConfig, error) {
+// Build the list of accessed bucket requests for the rotate-credentials RPC.
+func (d *internalGrantAccessConfig) RpcRotateBucketsList() (
+	out []*cosiproto.DriverRotateBucketAccessCredentialsRequest_AccessedBucket,
+) {
+	out = make([]*cosiproto.DriverRotateBucketAccessCredentialsRequest_AccessedBucket, 0, len(d.AccessConfigsByBucketId))
+
+	for id, cfg := range d.AccessConfigsByBucketId {
+		// As with grant, order is intentionally unpredictable.
+		out = append(out, &cosiproto.DriverRotateBucketAccessCredentialsRequest_AccessedBucket{
+			BucketId: id,
+			AccessMode: &cosiproto.AccessMode{
+				Mode: cfg.AccessMode,
+			},
+		})
+	}
+
+	return out
+}
+
+// Validate that the driver supports the capabilities needed to grant the access.
+func validateDriverSupportsAccess(driver DriverInfo, grantCfg *internalGrantAccessConfig) error {
+	errs := []error{}
+
+	if len(grantCfg.AccessConfigsByBucketId) > 1 && !driver.SupportsMultiBucketAccess() {
+		errs = append(errs, fmt.Errorf("driver %q does not support multi-bucket access", driver.Name))
+	}
+
+	if !driver.SupportsAuthenticationType(grantCfg.AuthenticationType) {
+		errs = append(errs, fmt.Errorf("driver %q does not support authentication type %q",
+			driver.Name, grantCfg.AuthenticationType.String()))
+	}
+
+	unsupportedModes := map[string]struct{}{}
+	for _, cfg := range grantCfg.AccessConfigsByBucketId {
+		if !driver.SupportsAccessMode(cfg.AccessMode) {
+			unsupportedModes[cfg.AccessMode.String()] = struct{}{}
+		}
+	}
+	if len(unsupportedModes) > 0 {
+		errs = append(errs, fmt.Errorf("driver %q does not support access modes: %v",
+			driver.Name, slices.Sorted(maps.Keys(unsupportedModes))))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Parse the access, and compile a new internal revoke-access config struct for the given account.
+func newInternalRevokeAccessConfig(
+	access *cosiapi.BucketAccess, accountID string,
+) (*internalRevokeAccessConfig, error) {
 	sharedCfg, err := newInternalAccessConfig(access)
 	if err != nil {
 		return nil, err
 	}
 
-	if access.Status.AccountID == "" {
+	if accountID == "" {
 		return nil, cosierr.NonRetryableError(
 			fmt.Errorf("cannot revoke access for BucketAccess with no account ID"))
 	}
@@ -499,17 +794,201 @@ func newInternalRevokeAccessConfig(access *cosiapi.BucketAccess) (*internalRevok
 	d := &internalRevokeAccessConfig{
 		internalAccessConfig: *sharedCfg,
 
-		AccountID:        access.Status.AccountID,
+		AccountID:        accountID,
 		RevokeBucketList: revokeList,
 	}
 	return d, nil
 }
 
+// Determine whether the access credentials should be rotated now. Rotation is due when a request
+// made via annotation is pending or when the credentials are older than the configured rotation
+// period.
+// Only credentials for the Key authentication type can be rotated.
+func credentialRotationDue(access *cosiapi.BucketAccess, now time.Time) bool {
+	if access.Status.AuthenticationType != cosiapi.BucketAccessAuthenticationTypeKey {
+		return false
+	}
+
+	if access.Status.CredentialRotationRequested {
+		return true
+	}
+
+	next := periodicCredentialRotationTime(access)
+	return !next.IsZero() && !now.Before(next)
+}
+
+// Get the time at which the access credentials are next due for periodic rotation.
+// Returns the zero time if periodic rotation does not apply to the access.
+func periodicCredentialRotationTime(access *cosiapi.BucketAccess) time.Time {
+	period := access.Status.CredentialRotationPeriod
+	if period == nil || period.Duration <= 0 {
+		return time.Time{}
+	}
+
+	// Credentials are first generated when the BucketAccess is provisioned.
+	lastRotated := access.CreationTimestamp.Time
+	if access.Status.LastCredentialRotationTime != nil {
+		lastRotated = access.Status.LastCredentialRotationTime.Time
+	}
+
+	return lastRotated.Add(period.Duration)
+}
+
+// Maximum number of previous accounts that can await revocation after zero-downtime credential
+// rotation. Matches the status.retiringAccounts list limit.
+const maxRetiringAccounts = 16
+
+// Credentials are renewed this long before they expire. The margin allows time for renewal retries
+// and for workloads to pick up renewed credentials from access Secrets.
+const credentialRenewalMargin = 10 * time.Minute
+
+// When credentials are already within the renewal margin after being renewed, wait at least this
+// long before renewing again so that drivers returning short-lived credentials aren't hot-looped.
+const minCredentialRenewalInterval = 30 * time.Second
+
+// Determine whether the access credentials reported by the driver have expired.
+func credentialsExpired(access *cosiapi.BucketAccess, now time.Time) bool {
+	expiry := access.Status.CredentialsExpiryTime
+	return expiry != nil && !now.Before(expiry.Time)
+}
+
+// Get the time at which credentials should be renewed ahead of expiry.
+// Returns the zero time if the credentials do not expire.
+func credentialRenewalTime(access *cosiapi.BucketAccess, now time.Time) time.Time {
+	expiry := access.Status.CredentialsExpiryTime
+	if expiry == nil {
+		return time.Time{}
+	}
+
+	renewAt := expiry.Add(-credentialRenewalMargin)
+	if earliest := now.Add(minCredentialRenewalInterval); renewAt.Before(earliest) {
+		renewAt = earliest
+	}
+	return renewAt
+}
+
+// While credentials can't be renewed, retry at least this often until they expire.
+const credentialRenewalRetryInterval = time.Minute
+
+// Get how long to wait before reconciling a BucketAccess again after a failed reconcile while its
+// credentials are still usable. A terminal error, or a long retry backoff, could otherwise leave
+// the access ready after its credentials expire. Retryable errors are retried before expiry.
+// Returns zero if the access has no usable credentials that expire.
+func credentialExpiryRequeueDelay(access *cosiapi.BucketAccess, retryable bool, now time.Time) time.Duration {
+	expiry := access.Status.CredentialsExpiryTime
+	if expiry == nil || !access.DeletionTimestamp.IsZero() || !ptr.Deref(access.Status.ReadyToUse, false) {
+		return 0
+	}
+
+	delay := expiry.Sub(now)
+	if retryable && delay > credentialRenewalRetryInterval {
+		delay = credentialRenewalRetryInterval
+	}
+	return max(delay, 0)
+}
+
+// Get the time at which a successfully-reconciled BucketAccess should next be reconciled to update
+// credentials, either to rotate them periodically or to renew them before they expire.
+// Returns the zero time if no reconcile is needed for credential updates.
+func nextCredentialUpdateTime(access *cosiapi.BucketAccess, now time.Time) time.Time {
+	if !access.DeletionTimestamp.IsZero() {
+		return time.Time{}
+	}
+
+	next := time.Time{}
+	setEarliest := func(t time.Time) {
+		if !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	// Previous accounts are revoked when their rotation grace period ends.
+	for _, ra := range access.Status.RetiringAccounts {
+		setEarliest(ra.RevokeAfter.Time)
+	}
+
+	if !ptr.Deref(access.Status.ReadyToUse, false) {
+		return next
+	}
+
+	if access.Status.AuthenticationType == cosiapi.BucketAccessAuthenticationTypeKey {
+		setEarliest(periodicCredentialRotationTime(access))
+	}
+
+	// Re-granting access renews expiring credentials.
+	setEarliest(credentialRenewalTime(access, now))
+
+	return next
+}
+
+// Call the driver to rotate granted access credentials. On success, the shared credential info of
+// the granted access is replaced by the rotated credentials.
+func (r *BucketAccessReconciler) driverRotateCredentials(
+	ctx context.Context,
+	logger logr.Logger,
+	access *cosiapi.BucketAccess,
+	grantCfg *internalGrantAccessConfig,
+	granted *grantedAccessApiDetails,
+) error {
+	if !r.DriverInfo.SupportsCredentialRotation() {
+		err := fmt.Errorf("driver %q does not support credential rotation", r.DriverInfo.Name)
+		logger.Error(err, "unable to rotate credentials")
+		return cosierr.NonRetryableError(err)
+	}
+
+	resp, err := r.DriverInfo.ProvisionerClient.DriverRotateBucketAccessCredentials(ctx,
+		&cosiproto.DriverRotateBucketAccessCredentialsRequest{
+			AccountId:          granted.AccountId,
+			Protocol:           &cosiproto.ObjectProtocol{Type: grantCfg.Protocol},
+			AuthenticationType: &cosiproto.AuthenticationType{Type: grantCfg.AuthenticationType},
+			Parameters:         grantCfg.Parameters,
+			Buckets:            grantCfg.RpcRotateBucketsList(),
+		},
+	)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			err = fmt.Errorf("driver does not support credential rotation: %w", err)
+			logger.Error(err, "DriverRotateBucketAccessCredentials error")
+			return cosierr.NonRetryableError(err)
+		}
+
+		logger.Error(err, "DriverRotateBucketAccessCredentials error")
+		if rpcErrorIsRetryable(status.Code(err)) {
+			return err
+		}
+		return cosierr.NonRetryableError(err)
+	}
+
+	validation := translator.ValidationConfig{
+		ExpectedProtocol:   access.Spec.Protocol,
+		AuthenticationType: access.Status.AuthenticationType,
+	}
+	credInfo, err := translator.CredentialsToApi(resp.Credentials, validation)
+	if err != nil {
+		err = fmt.Errorf("rotated credentials are invalid: %w", err)
+		logger.Error(err, "failed processing BucketAccess RPC response")
+		return cosierr.NonRetryableError(err)
+	}
+
+	expiry, err := parseCredentialsExpiryTime(resp.Credentials)
+	if err != nil {
+		err = fmt.Errorf("rotated credentials are invalid: %w", err)
+		logger.Error(err, "failed processing BucketAccess RPC response")
+		return cosierr.NonRetryableError(err)
+	}
+
+	granted.SharedCredentialInfo = credInfo
+	granted.CredentialsExpiryTime = expiry
+	return nil
+}
+
 // Internal API-domain details about a successfully-granted access.
 type grantedAccessApiDetails struct {
 	AccountId            string
 	SharedCredentialInfo map[string]string
-	BucketInfoByBucketId map[string]map[string]string
+	// nil if the driver did not report an expiry time for the credentials
+	CredentialsExpiryTime *metav1.Time
+	BucketInfoByBucketId  map[string]map[string]string
 }
 
 // Translate an RPC grant-access response to internal API-domain details.
@@ -528,6 +1007,11 @@ func translateDriverGrantBucketAccessResponseToApi(
 		errs = append(errs, fmt.Errorf("shared credentials are invalid: %w", err))
 	}
 
+	expiry, err := parseCredentialsExpiryTime(resp.Credentials)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("shared credentials are invalid: %w", err))
+	}
+
 	bucketInfoByBucketId := map[string]map[string]string{}
 	for i, accessBktInfo := range resp.Buckets {
 		id := accessBktInfo.BucketId
@@ -550,13 +1034,31 @@ func translateDriverGrantBucketAccessResponseToApi(
 	}
 
 	d := &grantedAccessApiDetails{
-		AccountId:            resp.AccountId, // DO NOT ALTER RESPONSE
-		SharedCredentialInfo: credInfo,
-		BucketInfoByBucketId: bucketInfoByBucketId,
+		AccountId:             resp.AccountId, // DO NOT ALTER RESPONSE
+		SharedCredentialInfo:  credInfo,
+		CredentialsExpiryTime: expiry,
+		BucketInfoByBucketId:  bucketInfoByBucketId,
 	}
 	return d, nil
 }
 
+// Parse the expiry time of RPC credentials, if the driver reported one.
+// Only Azure credentials currently carry an expiry time.
+func parseCredentialsExpiryTime(creds *cosiproto.CredentialInfo) (*metav1.Time, error) {
+	ts := creds.GetAzure().GetExpiryTimestamp()
+	if ts == "" {
+		return nil, nil
+	}
+
+	// ISO 8601 date+time, as specified by the COSI spec, is handled by RFC 3339 parsing.
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return nil, fmt.Errorf("expiry timestamp %q is not a valid ISO 8601 date+time: %w", ts, err)
+	}
+
+	return ptr.To(metav1.NewTime(t)), nil
+}
+
 // Even with a granted access response that translates successfully, there could be other errors
 // related to the granted access not matching what was requested.
 func validateGrantedAccess(grantCfg *internalGrantAccessConfig, granted *grantedAccessApiDetails) error {
@@ -608,7 +1110,9 @@ func (r *BucketAccessReconciler) updateSecretsWithGrantedInfo(
 		data := map[string]string{}
 		translator.MergeApiInfoIntoStringMap(granted.SharedCredentialInfo, data)
 		translator.MergeApiInfoIntoStringMap(bucketInfo, data)
+		sec.Data = nil // replace all data so that keys added by others don't linger
 		sec.StringData = data
+		metav1.SetMetaDataAnnotation(&sec.ObjectMeta, cosiapi.AccessSecretDataHashAnnotation, accessSecretDataHash(sec))
 
 		if err := r.Update(ctx, sec); err != nil {
 			errs = append(errs, fmt.Errorf("failed to update BucketAccess Secret %q with bucket and credential info", sec.Name))
@@ -669,6 +1173,11 @@ func validateBucketIsReadyForAccess(b *cosiapi.Bucket, expectedBucketID string)
 		errs = append(errs, fmt.Errorf("BucketClaim for Bucket %q is deleting", b.Name))
 	}
 
+	if _, ok := b.Annotations[cosiapi.BucketReleasedAnnotation]; ok {
+		//nolint:staticcheck // ST1005: okay to capitalize resource kind
+		errs = append(errs, fmt.Errorf("Bucket %q is released", b.Name))
+	}
+
 	if !b.DeletionTimestamp.IsZero() {
 		//nolint:staticcheck // ST1005: okay to capitalize resource kind
 		errs = append(errs, fmt.Errorf("Bucket %q is deleting", b.Name))
@@ -730,6 +1239,18 @@ func (r *BucketAccessReconciler) reserveAccessSecrets(
 			continue
 		}
 
+		if !existingSecret.DeletionTimestamp.IsZero() && metav1.IsControlledBy(existingSecret, access) {
+			// The access Secret was deleted by someone else. Allow deletion to finish, and replace it.
+			newSecret, err := r.replaceDeletingAccessSecret(ctx, existingSecret, access)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			secretsByName[secretName] = newSecret
+			continue
+		}
+
 		if err := r.updateExistingAccessSecretMetadata(ctx, existingSecret, access); err != nil {
 			errs = append(errs, err)
 			continue
@@ -778,6 +1299,22 @@ func (r *BucketAccessReconciler) createNewOwnedAccessSecret(
 	return s, nil
 }
 
+// Replace an access Secret that is deleting with a new one. The protection finalizer is removed so
+// that the deleting Secret is fully removed before a new Secret is created in its place.
+func (r *BucketAccessReconciler) replaceDeletingAccessSecret(
+	ctx context.Context,
+	secret *corev1.Secret,
+	owningAccess *cosiapi.BucketAccess,
+) (*corev1.Secret, error) {
+	if ctrlutil.RemoveFinalizer(secret, cosiapi.ProtectionFinalizer) {
+		if err := r.Update(ctx, secret); err != nil && !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to remove protection finalizer from deleting access Secret: %w", err)
+		}
+	}
+
+	return r.createNewOwnedAccessSecret(ctx, r.Client, secret.Name, owningAccess)
+}
+
 // Update an existing BucketAccess access Secret metadata to ensure it matches latest expectations.
 // For example, add the protection finalizer if it is removed.
 func (r *BucketAccessReconciler) updateExistingAccessSecretMetadata(
@@ -857,3 +1394,63 @@ func (r *BucketAccessReconciler) deleteOwnedAccessSecrets(
 	}
 	return nil
 }
+
+// Compute a hash of a Secret's data. StringData is merged over Data in the same way the apiserver
+// does when the Secret is written so that the hash is the same before and after writing.
+func accessSecretDataHash(secret *corev1.Secret) string {
+	data := map[string][]byte{}
+	for k, v := range secret.Data {
+		data[k] = v
+	}
+	for k, v := range secret.StringData {
+		data[k] = []byte(v)
+	}
+
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		// length-prefix keys and values so that different data can't produce the same byte stream
+		fmt.Fprintf(h, "%d:%s%d:", len(k), k, len(data[k]))
+		h.Write(data[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Implements a predicate that enqueues a reconcile for access Secrets that are changed by anything
+// other than the Sidecar. Delete events are always reconciled. Updates are reconciled when the
+// Secret begins deleting or when its data no longer matches what the Sidecar wrote.
+// Create events are only caused by the Sidecar reserving new access Secrets and are ignored.
+func accessSecretModifiedExternallyPredicate() ctrlpredicate.Funcs {
+	return ctrlpredicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecret, ok := e.ObjectOld.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+			newSecret, ok := e.ObjectNew.(*corev1.Secret)
+			if !ok {
+				return false
+			}
+
+			if oldSecret.DeletionTimestamp.IsZero() && !newSecret.DeletionTimestamp.IsZero() {
+				return true // deleted by someone else; Sidecar never deletes access Secrets it still needs
+			}
+
+			wantHash, populated := newSecret.Annotations[cosiapi.AccessSecretDataHashAnnotation]
+			if !populated {
+				// Not yet populated by the Sidecar, unless someone else removed the annotation.
+				_, wasPopulated := oldSecret.Annotations[cosiapi.AccessSecretDataHashAnnotation]
+				return wasPopulated
+			}
+			return wantHash != accessSecretDataHash(newSecret)
+		},
+	}
+}
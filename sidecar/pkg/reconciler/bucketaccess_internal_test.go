@@ -0,0 +1,370 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	cosiapi "sigs.k8s.io/container-object-storage-interface/client/apis/objectstorage/v1alpha2"
+	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
+)
+
+func Test_accessSecretDataHash(t *testing.T) {
+	stringData := &corev1.Secret{
+		StringData: map[string]string{"a": "1", "b": "2"},
+	}
+	data := &corev1.Secret{
+		Data: map[string][]byte{"b": []byte("2"), "a": []byte("1")},
+	}
+	merged := &corev1.Secret{
+		Data:       map[string][]byte{"a": []byte("old"), "b": []byte("2")},
+		StringData: map[string]string{"a": "1"},
+	}
+	// StringData is merged over Data the same way the apiserver does
+	assert.Equal(t, accessSecretDataHash(stringData), accessSecretDataHash(data))
+	assert.Equal(t, accessSecretDataHash(stringData), accessSecretDataHash(merged))
+
+	changed := &corev1.Secret{
+		StringData: map[string]string{"a": "1", "b": "3"},
+	}
+	assert.NotEqual(t, accessSecretDataHash(stringData), accessSecretDataHash(changed))
+
+	// keys and values can't run together
+	ab := &corev1.Secret{StringData: map[string]string{"a": "b"}}
+	a := &corev1.Secret{StringData: map[string]string{"ab": ""}}
+	assert.NotEqual(t, accessSecretDataHash(ab), accessSecretDataHash(a))
+}
+
+func Test_accessSecretModifiedExternallyPredicate(t *testing.T) {
+	populated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "creds",
+			Namespace:  "my-ns",
+			Finalizers: []string{cosiapi.ProtectionFinalizer},
+		},
+		StringData: map[string]string{"key": "value"},
+	}
+	metav1.SetMetaDataAnnotation(&populated.ObjectMeta, cosiapi.AccessSecretDataHashAnnotation,
+		accessSecretDataHash(populated))
+
+	unpopulated := populated.DeepCopy()
+	unpopulated.StringData = nil
+	unpopulated.Annotations = nil
+
+	p := accessSecretModifiedExternallyPredicate()
+
+	assert.False(t, p.Create(event.CreateEvent{Object: unpopulated}))
+	assert.True(t, p.Delete(event.DeleteEvent{Object: populated}))
+	assert.False(t, p.Generic(event.GenericEvent{Object: populated}))
+
+	tests := []struct {
+		name   string
+		old    *corev1.Secret
+		modify func(*corev1.Secret)
+		want   bool
+	}{
+		{"no change", populated, func(s *corev1.Secret) {}, false},
+		{"populated by sidecar", unpopulated, func(s *corev1.Secret) {
+			s.StringData = map[string]string{"key": "value"}
+			metav1.SetMetaDataAnnotation(&s.ObjectMeta, cosiapi.AccessSecretDataHashAnnotation, accessSecretDataHash(s))
+		}, false},
+		{"metadata updated by sidecar", unpopulated, func(s *corev1.Secret) {
+			s.Type = corev1.SecretTypeOpaque
+		}, false},
+		{"data modified", populated, func(s *corev1.Secret) {
+			s.StringData = map[string]string{"key": "other"}
+		}, true},
+		{"data key added", populated, func(s *corev1.Secret) {
+			s.StringData = map[string]string{"key": "value", "extra": "value"}
+		}, true},
+		{"data cleared", populated, func(s *corev1.Secret) {
+			s.StringData = nil
+		}, true},
+		{"hash annotation removed", populated, func(s *corev1.Secret) {
+			s.Annotations = nil
+		}, true},
+		{"unrelated label added", populated, func(s *corev1.Secret) {
+			s.Labels = map[string]string{"app": "mine"}
+		}, false},
+		{"deletion started", populated, func(s *corev1.Secret) {
+			s.DeletionTimestamp = ptr.To(metav1.Now())
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newSecret := tt.old.DeepCopy()
+			tt.modify(newSecret)
+			assert.Equal(t, tt.want, p.Update(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: newSecret}))
+		})
+	}
+}
+
+func Test_credentialRotationDue(t *testing.T) {
+	now := time.Now()
+	created := metav1.NewTime(now.Add(-48 * time.Hour))
+
+	tests := []struct {
+		name             string
+		authType         cosiapi.BucketAccessAuthenticationType
+		requested        bool
+		period           time.Duration
+		lastRotation     *time.Time
+		wantDue          bool
+		wantNextRotation time.Time
+	}{
+		{"no period, not requested", cosiapi.BucketAccessAuthenticationTypeKey, false, 0, nil, false, time.Time{}},
+		{"no period, requested", cosiapi.BucketAccessAuthenticationTypeKey, true, 0, nil, true, time.Time{}},
+		{"never rotated, period from creation elapsed", cosiapi.BucketAccessAuthenticationTypeKey, false,
+			24 * time.Hour, nil, true, created.Add(24 * time.Hour)},
+		{"never rotated, period from creation not elapsed", cosiapi.BucketAccessAuthenticationTypeKey, false,
+			72 * time.Hour, nil, false, created.Add(72 * time.Hour)},
+		{"rotated recently", cosiapi.BucketAccessAuthenticationTypeKey, false,
+			24 * time.Hour, ptr.To(now.Add(-time.Hour)), false, now.Add(23 * time.Hour)},
+		{"rotated recently, requested", cosiapi.BucketAccessAuthenticationTypeKey, true,
+			24 * time.Hour, ptr.To(now.Add(-time.Hour)), true, now.Add(23 * time.Hour)},
+		{"service account, requested", cosiapi.BucketAccessAuthenticationTypeServiceAccount, true,
+			24 * time.Hour, nil, false, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &cosiapi.BucketAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: created,
+				},
+				Status: cosiapi.BucketAccessStatus{
+					ReadyToUse:         ptr.To(true),
+					AuthenticationType: tt.authType,
+				},
+			}
+			access.Status.CredentialRotationRequested = tt.requested
+			if tt.period > 0 {
+				access.Status.CredentialRotationPeriod = &metav1.Duration{Duration: tt.period}
+			}
+			if tt.lastRotation != nil {
+				access.Status.LastCredentialRotationTime = ptr.To(metav1.NewTime(*tt.lastRotation))
+			}
+
+			assert.Equal(t, tt.wantDue, credentialRotationDue(access, now))
+			assert.WithinDuration(t, tt.wantNextRotation, nextCredentialUpdateTime(access, now), time.Second)
+
+			access.Status.ReadyToUse = ptr.To(false)
+			assert.True(t, nextCredentialUpdateTime(access, now).IsZero()) // no requeue until ready
+		})
+	}
+}
+
+func Test_nextCredentialUpdateTime_expiry(t *testing.T) {
+	now := time.Now()
+	created := metav1.NewTime(now.Add(-48 * time.Hour))
+
+	tests := []struct {
+		name        string
+		period      time.Duration
+		expiry      *time.Time
+		wantNext    time.Time
+		wantExpired bool
+	}{
+		{"no expiry", 0, nil, time.Time{}, false},
+		{"expiry far off", 0, ptr.To(now.Add(time.Hour)),
+			now.Add(time.Hour - credentialRenewalMargin), false},
+		{"expiry within renewal margin", 0, ptr.To(now.Add(time.Minute)),
+			now.Add(minCredentialRenewalInterval), false},
+		{"expired", 0, ptr.To(now.Add(-time.Minute)),
+			now.Add(minCredentialRenewalInterval), true},
+		{"periodic rotation before expiry", 72 * time.Hour, ptr.To(now.Add(48 * time.Hour)),
+			created.Add(72 * time.Hour), false},
+		{"expiry before periodic rotation", 72 * time.Hour, ptr.To(now.Add(time.Hour)),
+			now.Add(time.Hour - credentialRenewalMargin), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &cosiapi.BucketAccess{
+				ObjectMeta: metav1.ObjectMeta{
+					CreationTimestamp: created,
+				},
+				Status: cosiapi.BucketAccessStatus{
+					ReadyToUse:         ptr.To(true),
+					AuthenticationType: cosiapi.BucketAccessAuthenticationTypeKey,
+				},
+			}
+			if tt.period > 0 {
+				access.Status.CredentialRotationPeriod = &metav1.Duration{Duration: tt.period}
+			}
+			if tt.expiry != nil {
+				access.Status.CredentialsExpiryTime = ptr.To(metav1.NewTime(*tt.expiry))
+			}
+
+			assert.WithinDuration(t, tt.wantNext, nextCredentialUpdateTime(access, now), time.Second)
+			assert.Equal(t, tt.wantExpired, credentialsExpired(access, now))
+		})
+	}
+}
+
+func Test_credentialExpiryRequeueDelay(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		ready     bool
+		expiry    *time.Time
+		retryable bool
+		want      time.Duration
+	}{
+		{"no expiry", true, nil, true, 0},
+		{"not ready", false, ptr.To(now.Add(time.Hour)), false, 0},
+		{"expired", true, ptr.To(now.Add(-time.Minute)), false, 0},
+		{"retryable, expiry far off", true, ptr.To(now.Add(time.Hour)), true, credentialRenewalRetryInterval},
+		{"retryable, expiry imminent", true, ptr.To(now.Add(10 * time.Second)), true, 10 * time.Second},
+		{"non-retryable", true, ptr.To(now.Add(time.Hour)), false, time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access := &cosiapi.BucketAccess{
+				Status: cosiapi.BucketAccessStatus{ReadyToUse: ptr.To(tt.ready)},
+			}
+			if tt.expiry != nil {
+				access.Status.CredentialsExpiryTime = ptr.To(metav1.NewTime(*tt.expiry))
+			}
+
+			assert.InDelta(t, tt.want, credentialExpiryRequeueDelay(access, tt.retryable, now), float64(time.Second))
+		})
+	}
+}
+
+func Test_parseCredentialsExpiryTime(t *testing.T) {
+	tests := []struct {
+		name    string
+		creds   *cosiproto.CredentialInfo
+		want    *time.Time
+		wantErr bool
+	}{
+		{"nil", nil, nil, false},
+		{"s3", &cosiproto.CredentialInfo{S3: &cosiproto.S3CredentialInfo{AccessKeyId: "id"}}, nil, false},
+		{"azure, no expiry", &cosiproto.CredentialInfo{Azure: &cosiproto.AzureCredentialInfo{}}, nil, false},
+		{"azure, utc",
+			&cosiproto.CredentialInfo{Azure: &cosiproto.AzureCredentialInfo{ExpiryTimestamp: "2025-06-01T12:00:00Z"}},
+			ptr.To(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)), false},
+		{"azure, offset",
+			&cosiproto.CredentialInfo{Azure: &cosiproto.AzureCredentialInfo{ExpiryTimestamp: "2025-06-01T14:00:00+02:00"}},
+			ptr.To(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)), false},
+		{"azure, invalid",
+			&cosiproto.CredentialInfo{Azure: &cosiproto.AzureCredentialInfo{ExpiryTimestamp: "next tuesday"}},
+			nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCredentialsExpiryTime(tt.creds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.True(t, tt.want.Equal(got.Time))
+			}
+		})
+	}
+}
+
+func Test_nextCredentialUpdateTime_retiringAccounts(t *testing.T) {
+	now := time.Now()
+
+	access := &cosiapi.BucketAccess{
+		Status: cosiapi.BucketAccessStatus{
+			ReadyToUse:         ptr.To(true),
+			AuthenticationType: cosiapi.BucketAccessAuthenticationTypeKey,
+			RetiringAccounts: []cosiapi.RetiringAccount{
+				{AccountID: "later", RevokeAfter: metav1.NewTime(now.Add(2 * time.Hour))},
+				{AccountID: "sooner", RevokeAfter: metav1.NewTime(now.Add(time.Hour))},
+			},
+			CredentialsExpiryTime: ptr.To(metav1.NewTime(now.Add(3 * time.Hour))),
+		},
+	}
+	assert.WithinDuration(t, now.Add(time.Hour), nextCredentialUpdateTime(access, now), time.Second)
+
+	// retiring accounts are revoked even when the access is not ready
+	access.Status.ReadyToUse = ptr.To(false)
+	assert.WithinDuration(t, now.Add(time.Hour), nextCredentialUpdateTime(access, now), time.Second)
+
+	// deletion revokes all accounts
+	access.DeletionTimestamp = ptr.To(metav1.Now())
+	assert.True(t, nextCredentialUpdateTime(access, now).IsZero())
+}
+
+func Test_validateDriverSupportsAccess(t *testing.T) {
+	singleRw := map[string]bucketGrantAccessConfig{
+		"rw": {AccessMode: cosiproto.AccessMode_READ_WRITE},
+	}
+	multi := map[string]bucketGrantAccessConfig{
+		"rw": {AccessMode: cosiproto.AccessMode_READ_WRITE},
+		"wo": {AccessMode: cosiproto.AccessMode_WRITE_ONLY},
+	}
+
+	tests := []struct {
+		name         string
+		capabilities *cosiproto.DriverCapabilities
+		authType     cosiproto.AuthenticationType_Type
+		buckets      map[string]bucketGrantAccessConfig
+		wantErrs     []string
+	}{
+		{"capabilities not reported", nil, cosiproto.AuthenticationType_SERVICE_ACCOUNT, multi, nil},
+		{"single bucket, key", &cosiproto.DriverCapabilities{}, cosiproto.AuthenticationType_KEY, singleRw, nil},
+		{"service account unsupported", &cosiproto.DriverCapabilities{},
+			cosiproto.AuthenticationType_SERVICE_ACCOUNT, singleRw,
+			[]string{"does not support authentication type \"SERVICE_ACCOUNT\""}},
+		{"everything unsupported",
+			&cosiproto.DriverCapabilities{
+				SupportedAccessModes: []*cosiproto.AccessMode{{Mode: cosiproto.AccessMode_READ_ONLY}},
+			},
+			cosiproto.AuthenticationType_SERVICE_ACCOUNT, multi,
+			[]string{
+				"does not support multi-bucket access",
+				"does not support authentication type",
+				"does not support access modes: [READ_WRITE WRITE_ONLY]",
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driver := DriverInfo{Name: "cosi.test", Capabilities: tt.capabilities}
+			grantCfg := &internalGrantAccessConfig{
+				internalAccessConfig:    internalAccessConfig{AuthenticationType: tt.authType},
+				AccessConfigsByBucketId: tt.buckets,
+			}
+
+			err := validateDriverSupportsAccess(driver, grantCfg)
+			if len(tt.wantErrs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, want := range tt.wantErrs {
+				assert.ErrorContains(t, err, want)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package reconciler_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -26,6 +27,7 @@ import (
 	grpcstatus "google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -323,6 +325,8 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			assert.Equal(t, baseAccess.Spec, access.Spec) // spec should not change from base
 			assert.True(t, *access.Status.ReadyToUse)
 			assert.Nil(t, access.Status.Error)
+			assert.True(t, apimeta.IsStatusConditionTrue(access.Status.Conditions, cosiapi.ConditionAccessGranted))
+			assert.True(t, apimeta.IsStatusConditionTrue(access.Status.Conditions, cosiapi.ConditionReady))
 			assert.Equal(t, "cosi-ba-zxcvbn", access.Status.AccountID)
 			// should not modify what the Controller initialized
 			assert.Equal(t, initAccess.Status.AccessedBuckets, access.Status.AccessedBuckets)
@@ -335,6 +339,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 				require.Len(t, s.OwnerReferences, 1)
 				assert.Equal(t, "zxcvbn", string(s.OwnerReferences[0].UID))
 				assert.Equal(t, "sharedaccesskey", s.StringData[string(cosiapi.CredentialVar_S3_AccessKeyId)])
+				assert.Contains(t, s.Annotations, cosiapi.AccessSecretDataHashAnnotation)
 			}
 			assert.Equal(t, "corp-cosi-bc-qwerty", rwSec.StringData[string(cosiapi.BucketInfoVar_S3_BucketId)])
 			assert.Equal(t, "corp-cosi-bc-asdfgh", roSec.StringData[string(cosiapi.BucketInfoVar_S3_BucketId)])
@@ -415,7 +420,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				delReady := access.DeepCopy()
 				delReady.Status.ReadyToUse = ptr.To(true)
-				assert.Equal(t, initAccess.Status, delReady.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(delReady.Status))
 			}
 
 			// secrets are deleted
@@ -459,11 +464,19 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			assert.NotNil(t, access.Status.Error.Time)
 			assert.NotNil(t, access.Status.Error.Message)
 			assert.Contains(t, *access.Status.Error.Message, "fake rpc error")
+			blocked := apimeta.FindStatusCondition(access.Status.Conditions, cosiapi.ConditionDeletionBlocked)
+			require.NotNil(t, blocked)
+			assert.Equal(t, metav1.ConditionTrue, blocked.Status)
+			assert.Contains(t, blocked.Message, "fake rpc error")
+			ready := apimeta.FindStatusCondition(access.Status.Conditions, cosiapi.ConditionReady)
+			require.NotNil(t, ready)
+			assert.Equal(t, metav1.ConditionFalse, ready.Status)
+			assert.Equal(t, cosiapi.ReasonDeleting, ready.Reason)
 			{ // non-error/non-ready fields stay the same
 				delNoErr := access.DeepCopy()
 				delNoErr.Status.ReadyToUse = ptr.To(true)
 				delNoErr.Status.Error = nil
-				assert.Equal(t, initAccess.Status, delNoErr.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(delNoErr.Status))
 			}
 
 			// secrets are deleted
@@ -530,6 +543,356 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			assert.Equal(t, initRoBucket, roBucket)
 		})
 
+		t.Run("access Secret deleted by user", func(t *testing.T) {
+			tests := []struct {
+				name string
+				// whether the protection finalizer is removed before deletion
+				removeFinalizer bool
+			}{
+				{"deletion blocked by finalizer", false},
+				{"fully deleted", true},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					bootstrapped, r := testSuccessfulProvision(t, rpcClient)
+					ctx := bootstrapped.ContextWithLogger
+
+					initAccess, _, _, initRwSec, initRoSec := getAllResources(bootstrapped)
+
+					if tt.removeFinalizer {
+						initRwSec.Finalizers = nil
+						require.NoError(t, bootstrapped.Client.Update(ctx, initRwSec.DeepCopy()))
+					}
+					require.NoError(t, bootstrapped.Client.Delete(ctx, initRwSec.DeepCopy()))
+
+					grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{} // empty the seen rpc requests
+					grantError = nil
+					revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+					revokeError = nil
+
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+					assert.NoError(t, err)
+					assert.Empty(t, res)
+
+					// access is re-granted to re-populate the new Secret
+					require.Len(t, grantRequests, 1)
+					require.Len(t, revokeRequests, 0)
+					assertGrantRequest(t, grantRequests[0])
+
+					access, _, _, rwSec, roSec := getAllResources(bootstrapped)
+
+					assert.Equal(t, initAccess.Status, access.Status)
+
+					// deleted secret is re-created
+					assert.True(t, rwSec.DeletionTimestamp.IsZero())
+					assert.Contains(t, rwSec.GetFinalizers(), cosiapi.ProtectionFinalizer)
+					require.Len(t, rwSec.OwnerReferences, 1)
+					assert.Equal(t, "zxcvbn", string(rwSec.OwnerReferences[0].UID))
+					assert.Equal(t, initRwSec.StringData, rwSec.StringData)
+					assert.Equal(t, initRwSec.Annotations, rwSec.Annotations)
+
+					// other secret doesn't change
+					assert.Equal(t, initRoSec.StringData, roSec.StringData)
+				})
+			}
+		})
+
+		t.Run("access Secret data modified by user", func(t *testing.T) {
+			bootstrapped, r := testSuccessfulProvision(t, rpcClient)
+			ctx := bootstrapped.ContextWithLogger
+
+			_, _, _, initRwSec, initRoSec := getAllResources(bootstrapped)
+
+			modified := initRwSec.DeepCopy()
+			modified.StringData[string(cosiapi.CredentialVar_S3_AccessKeyId)] = "userkey"
+			modified.StringData["extra"] = "userdata"
+			require.NoError(t, bootstrapped.Client.Update(ctx, modified))
+
+			grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{} // empty the seen rpc requests
+			grantError = nil
+			revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+			revokeError = nil
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+
+			require.Len(t, grantRequests, 1)
+			assertGrantRequest(t, grantRequests[0])
+
+			_, _, _, rwSec, roSec := getAllResources(bootstrapped)
+
+			// secret data is re-populated with exactly the granted info
+			assert.Equal(t, initRwSec.StringData, rwSec.StringData)
+			assert.Equal(t, initRwSec.Annotations, rwSec.Annotations)
+			assert.Equal(t, initRoSec.StringData, roSec.StringData)
+		})
+
+		t.Run("credential rotation", func(t *testing.T) {
+			tests := []struct {
+				name string
+				// modifies the provisioned access before the next reconcile
+				requestRotation  bool
+				requestAccepted  bool // request already recorded in status, e.g., annotation removal failed
+				rotationPeriod   time.Duration
+				lastRotationTime time.Time
+				rotateError      error
+				// expected results
+				wantRotated      bool
+				wantRequeueAfter time.Duration // approximate
+				wantErr          string
+			}{
+				{"requested by annotation", true, false, 0, time.Time{}, nil, true, 0, ""},
+				{"request already accepted", true, true, 0, time.Time{}, nil, true, 0, ""},
+				{"periodic rotation due", false, false, time.Hour, time.Now().Add(-2 * time.Hour), nil, true, time.Hour, ""},
+				{"periodic rotation not due", false, false, time.Hour, time.Now().Add(-10 * time.Minute), nil, false, 50 * time.Minute, ""},
+				{"driver does not support rotation", true, false, 0, time.Time{},
+					grpcstatus.Error(codes.Unimplemented, "fake unimplemented"), false, 0, "does not support credential rotation"},
+				{"driver rotation error", true, false, 0, time.Time{},
+					grpcstatus.Error(codes.Unavailable, "fake rpc error"), false, 0, "fake rpc error"},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					bootstrapped, r := testSuccessfulProvision(t, rpcClient)
+					ctx := bootstrapped.ContextWithLogger
+
+					rotateRequests := []*cosiproto.DriverRotateBucketAccessCredentialsRequest{}
+					fakeServer.RotateCredentialsFunc = func(ctx context.Context, req *cosiproto.DriverRotateBucketAccessCredentialsRequest) (*cosiproto.DriverRotateBucketAccessCredentialsResponse, error) {
+						rotateRequests = append(rotateRequests, req)
+						return &cosiproto.DriverRotateBucketAccessCredentialsResponse{
+							Credentials: &cosiproto.CredentialInfo{
+								S3: &cosiproto.S3CredentialInfo{
+									AccessKeyId:     "rotatedaccesskey",
+									AccessSecretKey: "rotatedsecretkey",
+								},
+							},
+						}, tt.rotateError
+					}
+					defer func() {
+						fakeServer.RotateCredentialsFunc = nil
+					}()
+
+					initAccess, _, _, initRwSec, _ := getAllResources(bootstrapped)
+					if tt.requestRotation {
+						metav1.SetMetaDataAnnotation(&initAccess.ObjectMeta, cosiapi.RotateCredentialsAnnotation, "")
+						require.NoError(t, bootstrapped.Client.Update(ctx, initAccess))
+					}
+					if tt.rotationPeriod > 0 || tt.requestAccepted {
+						if tt.rotationPeriod > 0 {
+							initAccess.Status.CredentialRotationPeriod = &metav1.Duration{Duration: tt.rotationPeriod}
+							initAccess.Status.LastCredentialRotationTime = ptr.To(metav1.NewTime(tt.lastRotationTime))
+						}
+						initAccess.Status.CredentialRotationRequested = tt.requestAccepted
+						require.NoError(t, bootstrapped.Client.Status().Update(ctx, initAccess))
+					}
+
+					grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{} // empty the seen rpc requests
+					grantError = nil
+
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+					if tt.wantErr != "" {
+						assert.ErrorContains(t, err, tt.wantErr)
+					} else {
+						assert.NoError(t, err)
+					}
+					assert.InDelta(t, tt.wantRequeueAfter, res.RequeueAfter, float64(time.Minute))
+
+					require.Len(t, grantRequests, 1)
+					assertGrantRequest(t, grantRequests[0])
+
+					access, _, _, rwSec, roSec := getAllResources(bootstrapped)
+
+					if !tt.wantRotated {
+						assert.Equal(t, initAccess.Status.LastCredentialRotationTime, access.Status.LastCredentialRotationTime)
+						assert.Equal(t, initRwSec.StringData, rwSec.StringData)
+						if tt.requestRotation {
+							assert.NotContains(t, access.Annotations, cosiapi.RotateCredentialsAnnotation)
+							assert.True(t, access.Status.CredentialRotationRequested) // keep request
+						}
+						return
+					}
+
+					require.Len(t, rotateRequests, 1)
+					req := rotateRequests[0]
+					assert.Equal(t, "cosi-ba-zxcvbn", req.AccountId)
+					assert.Equal(t, cosiproto.AuthenticationType_KEY, req.AuthenticationType.Type)
+					assert.Equal(t, cosiproto.ObjectProtocol_S3, req.Protocol.Type)
+					assert.Len(t, req.Buckets, 2)
+
+					assert.True(t, *access.Status.ReadyToUse)
+					assert.Nil(t, access.Status.Error)
+					require.NotNil(t, access.Status.LastCredentialRotationTime)
+					assert.WithinDuration(t, time.Now(), access.Status.LastCredentialRotationTime.Time, time.Minute)
+					assert.NotContains(t, access.Annotations, cosiapi.RotateCredentialsAnnotation)
+					assert.False(t, access.Status.CredentialRotationRequested)
+
+					// the handled request does not trigger another rotation
+					_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+					assert.NoError(t, err)
+					assert.Len(t, rotateRequests, 1)
+
+					for _, sec := range []*corev1.Secret{rwSec, roSec} {
+						assert.Equal(t, "rotatedaccesskey", sec.StringData[string(cosiapi.CredentialVar_S3_AccessKeyId)])
+						assert.Equal(t, "rotatedsecretkey", sec.StringData[string(cosiapi.CredentialVar_S3_AccessSecretKey)])
+					}
+					assert.Equal(t, "corp-cosi-bc-qwerty", rwSec.StringData[string(cosiapi.BucketInfoVar_S3_BucketId)])
+				})
+			}
+		})
+
+		t.Run("unsupported driver capabilities", func(t *testing.T) {
+			tests := []struct {
+				name            string
+				capabilities    *cosiproto.DriverCapabilities
+				requestRotation bool
+				wantGrant       bool
+				wantErr         string
+			}{
+				{"multi-bucket access unsupported",
+					&cosiproto.DriverCapabilities{},
+					false, false, "does not support multi-bucket access"},
+				{"access mode unsupported",
+					&cosiproto.DriverCapabilities{
+						MultiBucketAccess:    true,
+						SupportedAccessModes: []*cosiproto.AccessMode{{Mode: cosiproto.AccessMode_READ_WRITE}},
+					},
+					false, false, "does not support access modes: [READ_ONLY]"},
+				{"credential rotation unsupported",
+					&cosiproto.DriverCapabilities{MultiBucketAccess: true},
+					true, true, "does not support credential rotation"},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					bootstrapped, r := testSuccessfulProvision(t, rpcClient)
+					ctx := bootstrapped.ContextWithLogger
+
+					r.DriverInfo.Capabilities = tt.capabilities
+					if tt.requestRotation {
+						access, _, _, _, _ := getAllResources(bootstrapped)
+						metav1.SetMetaDataAnnotation(&access.ObjectMeta, cosiapi.RotateCredentialsAnnotation, "")
+						require.NoError(t, bootstrapped.Client.Update(ctx, access))
+					}
+
+					grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{} // empty the seen rpc requests
+
+					res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+					assert.Empty(t, res)
+					assert.ErrorContains(t, err, tt.wantErr)
+					assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+					if tt.wantGrant {
+						assert.Len(t, grantRequests, 1)
+					} else {
+						assert.Empty(t, grantRequests)
+					}
+
+					access, _, _, _, _ := getAllResources(bootstrapped)
+					require.NotNil(t, access.Status.Error)
+					assert.Contains(t, *access.Status.Error.Message, tt.wantErr)
+				})
+			}
+		})
+
+		t.Run("zero-downtime credential rotation", func(t *testing.T) {
+			bootstrapped, r := testSuccessfulProvision(t, rpcClient)
+			ctx := bootstrapped.ContextWithLogger
+
+			// give each account distinct credentials
+			fakeServer.GrantBucketAccessFunc = func(ctx context.Context, dgbar *cosiproto.DriverGrantBucketAccessRequest) (*cosiproto.DriverGrantBucketAccessResponse, error) {
+				grantRequests = append(grantRequests, dgbar)
+				ret := newBaseGrantResponse(dgbar.AccountName)
+				ret.Credentials.S3.AccessKeyId = "key-" + dgbar.AccountName
+				return ret, grantError
+			}
+			defer func() {
+				fakeServer.GrantBucketAccessFunc = func(ctx context.Context, dgbar *cosiproto.DriverGrantBucketAccessRequest) (*cosiproto.DriverGrantBucketAccessResponse, error) {
+					grantRequests = append(grantRequests, dgbar)
+					return newBaseGrantResponse(dgbar.AccountName), grantError
+				}
+				revokeError = nil
+			}()
+
+			requestRotation := func(t *testing.T) {
+				access, _, _, _, _ := getAllResources(bootstrapped)
+				metav1.SetMetaDataAnnotation(&access.ObjectMeta, cosiapi.RotateCredentialsAnnotation, "")
+				require.NoError(t, bootstrapped.Client.Update(ctx, access))
+				grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{}   // empty the seen rpc requests
+				revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+			}
+
+			initAccess, _, _, _, _ := getAllResources(bootstrapped)
+			initAccess.Status.CredentialRotationGracePeriod = &metav1.Duration{Duration: time.Hour}
+			require.NoError(t, bootstrapped.Client.Status().Update(ctx, initAccess))
+
+			// new account is granted, and the previous account is kept alive
+			requestRotation(t)
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.NoError(t, err)
+			assert.InDelta(t, time.Hour, res.RequeueAfter, float64(time.Minute)) // revoke after grace period
+
+			require.Len(t, grantRequests, 2)
+			assert.Equal(t, "ba-zxcvbn", grantRequests[0].AccountName)
+			assert.Equal(t, "ba-zxcvbn-1", grantRequests[1].AccountName)
+			assert.Empty(t, revokeRequests)
+
+			access, _, _, rwSec, roSec := getAllResources(bootstrapped)
+			assert.True(t, *access.Status.ReadyToUse)
+			assert.Nil(t, access.Status.Error)
+			assert.Equal(t, "cosi-ba-zxcvbn-1", access.Status.AccountID)
+			assert.Equal(t, int64(1), access.Status.AccountGeneration)
+			require.Len(t, access.Status.RetiringAccounts, 1)
+			assert.Equal(t, "cosi-ba-zxcvbn", access.Status.RetiringAccounts[0].AccountID)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), access.Status.RetiringAccounts[0].RevokeAfter.Time, time.Minute)
+			require.NotNil(t, access.Status.LastCredentialRotationTime)
+			assert.NotContains(t, access.Annotations, cosiapi.RotateCredentialsAnnotation)
+			for _, sec := range []*corev1.Secret{rwSec, roSec} {
+				assert.Equal(t, "key-ba-zxcvbn-1", sec.StringData[string(cosiapi.CredentialVar_S3_AccessKeyId)])
+			}
+
+			// previous account is revoked once the grace period ends
+			access.Status.RetiringAccounts[0].RevokeAfter = metav1.NewTime(time.Now().Add(-time.Second))
+			require.NoError(t, bootstrapped.Client.Status().Update(ctx, access))
+			grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{}   // empty the seen rpc requests
+			revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+			revokeError = grpcstatus.Error(codes.Unavailable, "fake rpc error")
+
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.ErrorContains(t, err, "fake rpc error")
+			require.Len(t, revokeRequests, 1)
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.Len(t, access.Status.RetiringAccounts, 1) // revocation is retried
+
+			revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+			revokeError = nil
+			res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.NoError(t, err)
+			assert.Empty(t, res)
+			require.Len(t, revokeRequests, 1)
+			assert.Equal(t, "cosi-ba-zxcvbn", revokeRequests[0].AccountId)
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.Equal(t, "cosi-ba-zxcvbn-1", access.Status.AccountID)
+			assert.Empty(t, access.Status.RetiringAccounts)
+			assert.Nil(t, access.Status.Error)
+
+			// all accounts are revoked on deletion
+			requestRotation(t)
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.NoError(t, err)
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.Equal(t, "cosi-ba-zxcvbn-2", access.Status.AccountID)
+			assert.Equal(t, int64(2), access.Status.AccountGeneration)
+			require.Len(t, access.Status.RetiringAccounts, 1)
+
+			require.NoError(t, bootstrapped.Client.Delete(ctx, access.DeepCopy()))
+			grantRequests = []*cosiproto.DriverGrantBucketAccessRequest{}   // empty the seen rpc requests
+			revokeRequests = []*cosiproto.DriverRevokeBucketAccessRequest{} // empty the seen rpc requests
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&baseAccess)})
+			assert.NoError(t, err)
+			assert.Empty(t, grantRequests)
+			require.Len(t, revokeRequests, 2)
+			assert.Equal(t, "cosi-ba-zxcvbn-1", revokeRequests[0].AccountId)
+			assert.Equal(t, "cosi-ba-zxcvbn-2", revokeRequests[1].AccountId)
+		})
+
 		t.Run("subsequent error reporting and clearing", func(t *testing.T) {
 			// RPC errors should be reported for debugging without modifying provisioned status
 
@@ -566,10 +929,15 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 				assert.NotNil(t, access.Status.Error.Time)
 				assert.NotNil(t, access.Status.Error.Message)
 				assert.Contains(t, *access.Status.Error.Message, "fake rpc error")
+				granted := apimeta.FindStatusCondition(access.Status.Conditions, cosiapi.ConditionAccessGranted)
+				require.NotNil(t, granted)
+				assert.Equal(t, metav1.ConditionFalse, granted.Status)
+				assert.Equal(t, "DriverUnknown", granted.Reason)
+				assert.Equal(t, access.Generation, granted.ObservedGeneration)
 				{ // non-error fields stay the same
 					noErr := access.DeepCopy()
 					noErr.Status.Error = nil
-					assert.Equal(t, initAccess.Status, noErr.Status)
+					assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(noErr.Status))
 				}
 
 				// secrets don't change
@@ -656,7 +1024,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 				{ // non-error fields stay the same
 					delReady := access.DeepCopy()
 					delReady.Status.ReadyToUse = ptr.To(true)
-					assert.Equal(t, initAccess.Status, delReady.Status)
+					assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(delReady.Status))
 				}
 
 				// secrets are deleted
@@ -733,7 +1101,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				accessNoError := access.DeepCopy()
 				accessNoError.Status.Error = nil
-				assert.Equal(t, initAccess.Status, accessNoError.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(accessNoError.Status))
 			}
 
 			// pre-existing secret that was already owned hasn't been touched
@@ -772,7 +1140,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				initNoErr := initAccess.DeepCopy()
 				initNoErr.Status.Error = nil
-				assert.Equal(t, initNoErr.Status, access.Status)
+				assert.Equal(t, withoutConditions(initNoErr.Status), withoutConditions(access.Status))
 			}
 
 			// pre-existing secret unmodified
@@ -845,7 +1213,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 		{ // non-error fields stay the same
 			initNoErr := initAccess.DeepCopy()
 			initNoErr.Status.Error = nil
-			assert.Equal(t, initNoErr.Status, access.Status)
+			assert.Equal(t, withoutConditions(initNoErr.Status), withoutConditions(access.Status))
 		}
 
 		// all secrets are gone
@@ -908,7 +1276,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				accessNoError := access.DeepCopy()
 				accessNoError.Status.Error = nil
-				assert.Equal(t, initAccess.Status, accessNoError.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(accessNoError.Status))
 			}
 
 			// first secret has been reserved successfully
@@ -989,7 +1357,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				accessNoError := access.DeepCopy()
 				accessNoError.Status.Error = nil
-				assert.Equal(t, malformedAccess.Status, accessNoError.Status)
+				assert.Equal(t, withoutConditions(malformedAccess.Status), withoutConditions(accessNoError.Status))
 			}
 
 			// don't care if secrets exist
@@ -1064,7 +1432,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				accessNoError := access.DeepCopy()
 				accessNoError.Status.Error = nil
-				assert.Equal(t, initAccess.Status, accessNoError.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(accessNoError.Status))
 			}
 
 			// don't care if secrets exist
@@ -1140,7 +1508,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-error fields stay the same
 				accessNoError := access.DeepCopy()
 				accessNoError.Status.Error = nil
-				assert.Equal(t, initAccess.Status, accessNoError.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(accessNoError.Status))
 			}
 
 			// don't care if secrets exist
@@ -1452,7 +1820,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 				{ // non-error fields stay the same
 					accessNoError := access.DeepCopy()
 					accessNoError.Status.Error = nil
-					assert.Equal(t, initAccess.Status, accessNoError.Status)
+					assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(accessNoError.Status))
 				}
 
 				// secrets should have been created to claim them, but not updated with data
@@ -1484,15 +1852,21 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 
 	t.Run("azure protocol and serviceaccount auth", func(t *testing.T) {
 		grantRequests := []*cosiproto.DriverGrantBucketAccessRequest{}
+		var grantError error
+		credentialExpiry := ""
 		revokeRequests := []*cosiproto.DriverRevokeBucketAccessRequest{}
 		fakeServer := cositest.FakeProvisionerServer{
 			GrantBucketAccessFunc: func(ctx context.Context, dgbar *cosiproto.DriverGrantBucketAccessRequest) (*cosiproto.DriverGrantBucketAccessResponse, error) {
 				grantRequests = append(grantRequests, dgbar)
+				if grantError != nil {
+					return nil, grantError
+				}
 				ret := &cosiproto.DriverGrantBucketAccessResponse{
 					AccountId: "cosi-" + dgbar.AccountName,
 					Credentials: &cosiproto.CredentialInfo{
 						Azure: &cosiproto.AzureCredentialInfo{
-							// empty for ServiceAccount auth
+							// token empty for ServiceAccount auth
+							ExpiryTimestamp: credentialExpiry,
 						},
 					},
 					Buckets: []*cosiproto.DriverGrantBucketAccessResponse_BucketInfo{
@@ -1658,6 +2032,72 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			testAzureAndServiceAccount(t)
 		})
 
+		t.Run("expiring credentials", func(t *testing.T) {
+			bootstrapped, r := testAzureAndServiceAccount(t)
+			ctx := bootstrapped.ContextWithLogger
+			defer func() {
+				credentialExpiry = ""
+				grantError = nil
+			}()
+
+			// driver begins reporting an expiry time for credentials
+			expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+			credentialExpiry = expiry.Format(time.RFC3339)
+
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.NoError(t, err)
+			assert.InDelta(t, 50*time.Minute, res.RequeueAfter, float64(time.Minute)) // renew before expiry
+
+			access, _, _, _, _ := getAllResources(bootstrapped)
+			assert.True(t, *access.Status.ReadyToUse)
+			require.NotNil(t, access.Status.CredentialsExpiryTime)
+			assert.True(t, expiry.Equal(access.Status.CredentialsExpiryTime.Time))
+
+			// renewal fails before credentials expire: access remains usable, and renewal is retried
+			grantError = grpcstatus.Error(codes.Unavailable, "driver unavailable")
+			res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.NoError(t, err)
+			assert.Equal(t, time.Minute, res.RequeueAfter)
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.True(t, *access.Status.ReadyToUse)
+			require.NotNil(t, access.Status.Error)
+
+			// renewal fails terminally before credentials expire: reconcile again at expiry
+			grantError = grpcstatus.Error(codes.InvalidArgument, "invalid argument")
+			res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.NoError(t, err)
+			assert.InDelta(t, time.Until(expiry), res.RequeueAfter, float64(time.Minute))
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.True(t, *access.Status.ReadyToUse)
+			require.NotNil(t, access.Status.Error)
+			assert.Contains(t, *access.Status.Error.Message, "invalid argument")
+
+			// renewal still failing after credentials expire: access is no longer usable
+			access.Status.CredentialsExpiryTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Minute)))
+			require.NoError(t, bootstrapped.Client.Status().Update(ctx, access))
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.False(t, *access.Status.ReadyToUse)
+
+			// renewal succeeds with invalid expiry
+			grantError = nil
+			credentialExpiry = "tomorrow"
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.ErrorIs(t, err, reconcile.TerminalError(nil))
+			assert.ErrorContains(t, err, "expiry timestamp")
+
+			// renewal succeeds with new credentials
+			credentialExpiry = expiry.Add(time.Hour).Format(time.RFC3339)
+			res, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: cositest.NsName(&azureAccess)})
+			assert.NoError(t, err)
+			assert.InDelta(t, 110*time.Minute, res.RequeueAfter, float64(time.Minute))
+			access, _, _, _, _ = getAllResources(bootstrapped)
+			assert.True(t, *access.Status.ReadyToUse)
+			assert.Nil(t, access.Status.Error)
+			assert.True(t, expiry.Add(time.Hour).Equal(access.Status.CredentialsExpiryTime.Time))
+		})
+
 		t.Run("subsequent deletion", func(t *testing.T) {
 			bootstrapped, r := testAzureAndServiceAccount(t)
 			ctx := bootstrapped.ContextWithLogger
@@ -1694,7 +2134,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-ready fields stay the same
 				delReady := access.DeepCopy()
 				delReady.Status.ReadyToUse = ptr.To(true)
-				assert.Equal(t, initAccess.Status, delReady.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(delReady.Status))
 			}
 
 			// secrets are deleted
@@ -1943,7 +2383,7 @@ func TestBucketAccessReconciler_Reconcile(t *testing.T) {
 			{ // non-ready fields stay the same
 				delReady := access.DeepCopy()
 				delReady.Status.ReadyToUse = ptr.To(true)
-				assert.Equal(t, initAccess.Status, delReady.Status)
+				assert.Equal(t, withoutConditions(initAccess.Status), withoutConditions(delReady.Status))
 			}
 
 			// secrets are deleted
@@ -1970,3 +2410,9 @@ func accessedBucketRequestExists(
 	}
 	return false
 }
+
+// return the status without conditions, for comparing statuses where only conditions are expected to change
+func withoutConditions(s cosiapi.BucketAccessStatus) cosiapi.BucketAccessStatus {
+	s.Conditions = nil
+	return s
+}
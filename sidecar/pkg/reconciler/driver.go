@@ -19,6 +19,8 @@ package reconciler
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -31,11 +33,18 @@ import (
 	cosiproto "sigs.k8s.io/container-object-storage-interface/proto"
 )
 
+// While the driver is disconnected, reconciles are paused and retried after this delay.
+const driverDisconnectedRequeueDelay = 5 * time.Second
+
 // DriverInfo contains critical info about the paired driver that is needed by all reconcilers
 type DriverInfo struct {
 	Name               string
 	SupportedProtocols []cosiproto.ObjectProtocol_Type
 
+	// Capabilities reported by the driver. If nil, the driver did not report capabilities, and all
+	// optional features are assumed to be supported.
+	Capabilities *cosiproto.DriverCapabilities
+
 	ProvisionerClient cosiproto.ProvisionerClient
 }
 
@@ -49,6 +58,47 @@ func (d *DriverInfo) SupportsProtocol(p cosiproto.ObjectProtocol_Type) bool {
 	return false
 }
 
+// SupportsMultiBucketAccess returns true if the driver supports granting access to multiple buckets.
+func (d *DriverInfo) SupportsMultiBucketAccess() bool {
+	return d.Capabilities == nil || d.Capabilities.MultiBucketAccess
+}
+
+// SupportsBucketDeletion returns true if the driver supports deleting backend buckets.
+func (d *DriverInfo) SupportsBucketDeletion() bool {
+	return d.Capabilities == nil || d.Capabilities.BucketDeletion
+}
+
+// SupportsStaticProvisioning returns true if the driver supports statically-provisioned buckets.
+func (d *DriverInfo) SupportsStaticProvisioning() bool {
+	return d.Capabilities == nil || d.Capabilities.StaticProvisioning
+}
+
+// SupportsAuthenticationType returns true if the driver supports the given authentication type.
+func (d *DriverInfo) SupportsAuthenticationType(t cosiproto.AuthenticationType_Type) bool {
+	if t == cosiproto.AuthenticationType_SERVICE_ACCOUNT {
+		return d.Capabilities == nil || d.Capabilities.ServiceAccountAuthentication
+	}
+	return true
+}
+
+// SupportsCredentialRotation returns true if the driver supports rotating access credentials.
+func (d *DriverInfo) SupportsCredentialRotation() bool {
+	return d.Capabilities == nil || d.Capabilities.CredentialRotation
+}
+
+// SupportsAccessMode returns true if the driver supports the given bucket access mode.
+func (d *DriverInfo) SupportsAccessMode(m cosiproto.AccessMode_Mode) bool {
+	if d.Capabilities == nil || len(d.Capabilities.SupportedAccessModes) == 0 {
+		return true
+	}
+	for _, sm := range d.Capabilities.SupportedAccessModes {
+		if sm.GetMode() == m {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateAndSetDriverConnectionInfo parses and validates the driver's reported info and returns a
 // struct needed by reconcilers to connect with the driver.
 func ValidateAndSetDriverConnectionInfo(
@@ -65,15 +115,68 @@ func ValidateAndSetDriverConnectionInfo(
 		return nil, fmt.Errorf("supported protocols list is invalid: %w", err)
 	}
 
+	if err := validateCapabilities(driverReportedInfo.GetCapabilities()); err != nil {
+		return nil, fmt.Errorf("capabilities are invalid: %w", err)
+	}
+
 	di := &DriverInfo{
 		Name:               driverReportedInfo.Name,
 		SupportedProtocols: parsedProtocols,
+		Capabilities:       driverReportedInfo.GetCapabilities(),
 
 		ProvisionerClient: cosiproto.NewProvisionerClient(conn),
 	}
 	return di, nil
 }
 
+// DriverConnection tracks the state of the connection to the paired driver. The driver may restart
+// and report different protocols or capabilities after the Sidecar reconnects, so reconcilers must
+// use Info() to get the current DriverInfo. It is safe for concurrent use.
+type DriverConnection struct {
+	mu        sync.RWMutex
+	info      DriverInfo
+	connected bool
+}
+
+// NewDriverConnection returns a DriverConnection for a connected driver with the given info.
+func NewDriverConnection(info DriverInfo) *DriverConnection {
+	return &DriverConnection{
+		info:      info,
+		connected: true,
+	}
+}
+
+// Info returns the current DriverInfo, and whether the driver is currently connected.
+func (c *DriverConnection) Info() (DriverInfo, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.info, c.connected
+}
+
+// SetDisconnected records that the connection to the driver was lost.
+func (c *DriverConnection) SetDisconnected() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+}
+
+// SetConnected records that the driver is connected and reports the given info.
+func (c *DriverConnection) SetConnected(info DriverInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.info = info
+	c.connected = true
+}
+
+// Get the DriverInfo a reconcile should use, and whether the driver is connected. If conn is nil,
+// the static info is used, and the driver is assumed to be connected.
+func currentDriverInfo(static DriverInfo, conn *DriverConnection) (DriverInfo, bool) {
+	if conn == nil {
+		return static, true
+	}
+	return conn.Info()
+}
+
 // validate driver name matches requirements
 func validateDriverName(n string) error {
 	allErrs := []string{}
@@ -125,6 +228,16 @@ func validateAndParseProtocols(objProtocols []*cosiproto.ObjectProtocol) ([]cosi
 	return out, nil
 }
 
+// validate driver capabilities (if given)
+func validateCapabilities(c *cosiproto.DriverCapabilities) error {
+	for _, am := range c.GetSupportedAccessModes() {
+		if am.GetMode() == cosiproto.AccessMode_UNKNOWN {
+			return fmt.Errorf("access mode %q is unknown", am.String())
+		}
+	}
+	return nil
+}
+
 // Implements a predicate that enqueues a reconcile for any event of any type if (and only if) the
 // driver name of the object matches the given driver name.
 func driverNameMatchesPredicate(driverName string) ctrlpredicate.Funcs {
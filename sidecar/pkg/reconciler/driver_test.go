@@ -217,5 +217,116 @@ func TestValidateAndSetDriverConnectionInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "seven.of.nine", driverInfo.Name)
 		assert.Equal(t, []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_S3}, driverInfo.SupportedProtocols)
+		assert.Nil(t, driverInfo.Capabilities)
 	})
+
+	t.Run("invalid capabilities", func(t *testing.T) {
+		conn := &grpc.ClientConn{}
+		response := &cosiproto.DriverGetInfoResponse{
+			Name: "seven.of.nine",
+			SupportedProtocols: []*cosiproto.ObjectProtocol{
+				{Type: cosiproto.ObjectProtocol_S3},
+			},
+			Capabilities: &cosiproto.DriverCapabilities{
+				SupportedAccessModes: []*cosiproto.AccessMode{
+					{Mode: cosiproto.AccessMode_UNKNOWN},
+				},
+			},
+		}
+		driverInfo, err := ValidateAndSetDriverConnectionInfo(response, conn)
+		assert.ErrorContains(t, err, "capabilities are invalid")
+		assert.Nil(t, driverInfo)
+	})
+
+	t.Run("valid response with capabilities", func(t *testing.T) {
+		conn := &grpc.ClientConn{}
+		response := &cosiproto.DriverGetInfoResponse{
+			Name: "seven.of.nine",
+			SupportedProtocols: []*cosiproto.ObjectProtocol{
+				{Type: cosiproto.ObjectProtocol_S3},
+			},
+			Capabilities: &cosiproto.DriverCapabilities{
+				MultiBucketAccess: true,
+				SupportedAccessModes: []*cosiproto.AccessMode{
+					{Mode: cosiproto.AccessMode_READ_ONLY},
+				},
+			},
+		}
+		driverInfo, err := ValidateAndSetDriverConnectionInfo(response, conn)
+		assert.NoError(t, err)
+		assert.True(t, driverInfo.SupportsMultiBucketAccess())
+		assert.False(t, driverInfo.SupportsBucketDeletion())
+		assert.True(t, driverInfo.SupportsAccessMode(cosiproto.AccessMode_READ_ONLY))
+		assert.False(t, driverInfo.SupportsAccessMode(cosiproto.AccessMode_READ_WRITE))
+	})
+}
+
+func TestDriverInfo_capabilities(t *testing.T) {
+	t.Run("capabilities not reported", func(t *testing.T) {
+		d := DriverInfo{}
+		assert.True(t, d.SupportsMultiBucketAccess())
+		assert.True(t, d.SupportsBucketDeletion())
+		assert.True(t, d.SupportsStaticProvisioning())
+		assert.True(t, d.SupportsAuthenticationType(cosiproto.AuthenticationType_SERVICE_ACCOUNT))
+		assert.True(t, d.SupportsCredentialRotation())
+		assert.True(t, d.SupportsAccessMode(cosiproto.AccessMode_WRITE_ONLY))
+	})
+
+	t.Run("no capabilities", func(t *testing.T) {
+		d := DriverInfo{Capabilities: &cosiproto.DriverCapabilities{}}
+		assert.False(t, d.SupportsMultiBucketAccess())
+		assert.False(t, d.SupportsBucketDeletion())
+		assert.False(t, d.SupportsStaticProvisioning())
+		assert.False(t, d.SupportsAuthenticationType(cosiproto.AuthenticationType_SERVICE_ACCOUNT))
+		assert.True(t, d.SupportsAuthenticationType(cosiproto.AuthenticationType_KEY)) // always supported
+		assert.False(t, d.SupportsCredentialRotation())
+		assert.True(t, d.SupportsAccessMode(cosiproto.AccessMode_WRITE_ONLY)) // empty list means all
+	})
+
+	t.Run("all capabilities", func(t *testing.T) {
+		d := DriverInfo{Capabilities: &cosiproto.DriverCapabilities{
+			MultiBucketAccess:            true,
+			BucketDeletion:               true,
+			StaticProvisioning:           true,
+			ServiceAccountAuthentication: true,
+			CredentialRotation:           true,
+			SupportedAccessModes: []*cosiproto.AccessMode{
+				{Mode: cosiproto.AccessMode_READ_WRITE},
+				{Mode: cosiproto.AccessMode_READ_ONLY},
+				{Mode: cosiproto.AccessMode_WRITE_ONLY},
+			},
+		}}
+		assert.True(t, d.SupportsMultiBucketAccess())
+		assert.True(t, d.SupportsBucketDeletion())
+		assert.True(t, d.SupportsStaticProvisioning())
+		assert.True(t, d.SupportsAuthenticationType(cosiproto.AuthenticationType_SERVICE_ACCOUNT))
+		assert.True(t, d.SupportsCredentialRotation())
+		assert.True(t, d.SupportsAccessMode(cosiproto.AccessMode_WRITE_ONLY))
+	})
+}
+
+func TestDriverConnection(t *testing.T) {
+	static := DriverInfo{Name: "static.cosi.test"}
+
+	info, connected := currentDriverInfo(static, nil)
+	assert.Equal(t, static, info)
+	assert.True(t, connected)
+
+	conn := NewDriverConnection(DriverInfo{Name: "initial.cosi.test"})
+	info, connected = currentDriverInfo(static, conn)
+	assert.Equal(t, "initial.cosi.test", info.Name)
+	assert.True(t, connected)
+
+	conn.SetDisconnected()
+	info, connected = currentDriverInfo(static, conn)
+	assert.Equal(t, "initial.cosi.test", info.Name)
+	assert.False(t, connected)
+
+	conn.SetConnected(DriverInfo{
+		Name:               "initial.cosi.test",
+		SupportedProtocols: []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_GCS},
+	})
+	info, connected = currentDriverInfo(static, conn)
+	assert.Equal(t, []cosiproto.ObjectProtocol_Type{cosiproto.ObjectProtocol_GCS}, info.SupportedProtocols)
+	assert.True(t, connected)
 }